@@ -4,7 +4,7 @@ import { Input } from "./ui/input";
 import { Button } from "./ui/button";
 import { ChevronLeft, ChevronRight, LayoutTemplate, Search, Send } from "lucide-react";
 import { motion, AnimatePresence } from "framer-motion";
-import bibleService, { VerseMatch } from "../services/bible";
+import bibleService, { Translation, VerseMatch } from "../services/bible";
 import coversService, { SermonCover } from "../services/covers";
 import { useLiveContext } from "../contexts/LiveContext";
 import { useBackendContext } from "../contexts/BackendContext";
@@ -17,13 +17,18 @@ import { normalizeCoverDoc } from "../services/mediaUrls";
 
 interface Verse {
     index: number;
+    last?: number;
     text: string;
 }
 
+// A bridge such as 3-4 is stored once under 3, with last set to 4.
+const verseNumber = (verse: Verse) => (verse.last ? `${verse.index}-${verse.last}` : `${verse.index}`);
+
 interface Chapter {
     name: string;
     research: string;
     verses: Verse[];
+    translation?: Translation;
 }
 
 interface RecentSearch {
@@ -208,8 +213,9 @@ const BibleSearch: React.FC = () => {
 
     const sendVerseSelection = useCallback((chapter: Chapter, verse: Verse, forceLive?: boolean) => {
         const selectedVerse = {
-            reference: `${chapter.name}:${verse.index}`,
+            reference: `${chapter.name}:${verseNumber(verse)}`,
             text: verse.text,
+            translation: chapter.translation?.attribution,
         };
         sendScene(
             {
@@ -312,9 +318,11 @@ const BibleSearch: React.FC = () => {
         try {
             const { start, end } = buildOffsetLimit(verseStart, verseEnd);
             const searchResult = await bibleService.getChapter(bookKey, chapter, 1, 0, 0);
-            const targetIndex = start && start > 0
-                ? Math.min(start - 1, Math.max(searchResult.verses.length - 1, 0))
+            // A bridge such as 3-4 is one entry, so look the verse up by number.
+            const found = start && start > 0
+                ? searchResult.verses.findIndex((verse: Verse) => Math.max(verse.index, verse.last ?? 0) >= start)
                 : 0;
+            const targetIndex = found < 0 ? Math.max(searchResult.verses.length - 1, 0) : found;
 
             setSelectedChapter(searchResult);
             setCurrentVerseIndex(targetIndex);
@@ -791,7 +799,7 @@ const BibleSearch: React.FC = () => {
                                             transition={{ duration: 0.25 }}
                                         >
                                             <p className="text-[10px] uppercase tracking-[0.3em] text-white/70">
-                                                {selectedChapter.name}:{currentVerse.index}
+                                                {selectedChapter.name}:{verseNumber(currentVerse)}
                                             </p>
                                             <p className="mt-4 text-lg font-medium leading-relaxed text-white">
                                                 {currentVerse.text}
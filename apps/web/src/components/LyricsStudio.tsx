@@ -8,15 +8,27 @@ import {
     GripVertical,
     Layers,
     ListMusic,
+    ListOrdered,
     Music,
     PenLine,
     Plus,
     Save,
     Send,
+    Star,
+    Tags,
     Trash2,
+    Upload,
+    X,
     Wand2
 } from "lucide-react";
-import lyricsService, { LyricsSegment, LyricsSettings, LyricsSongSummary } from "../services/lyrics";
+import lyricsService, {
+    LyricsArrangement,
+    LyricsImportReport,
+    LyricsSegment,
+    LyricsSegmentation,
+    LyricsSettings,
+    LyricsSongSummary
+} from "../services/lyrics";
 import { useLiveContext } from "../contexts/LiveContext";
 import SceneRenderer from "./live/SceneRenderer";
 import AccordionSection from "./ui/accordion-section";
@@ -47,6 +59,31 @@ const defaultSettings: LyricsSettings = {
     highlightColor: "#22c55e",
 };
 
+// Authors and tags are edited as comma separated text.
+type SongDetails = {
+    authors: string;
+    copyright: string;
+    ccli: string;
+    key: string;
+    tempo: number;
+    tags: string;
+};
+
+const emptyDetails: SongDetails = { authors: "", copyright: "", ccli: "", key: "", tempo: 0, tags: "" };
+
+const splitList = (value: string) => value.split(",").map(item => item.trim()).filter(Boolean);
+
+// Same line the backend returns as `credits`, shown on the last slide.
+const buildCreditLine = (details: SongDetails) => {
+    const parts: string[] = [];
+    const authors = splitList(details.authors);
+    if (authors.length) parts.push(authors.join(", "));
+    const copyright = details.copyright.trim();
+    if (copyright) parts.push(copyright.startsWith("©") ? copyright : `© ${copyright}`);
+    if (details.ccli) parts.push(`CCLI ${details.ccli}`);
+    return parts.join(" · ");
+};
+
 const buildId = () => `${Date.now()}-${Math.random().toString(16).slice(2)}`;
 const LIVE_SNAPSHOT_KEY = "ionicx:lyricsLiveSnapshot";
 
@@ -59,7 +96,16 @@ export default function LyricsStudio() {
     const [settings, setSettings] = useState<LyricsSettings>(defaultSettings);
     const [selectedText, setSelectedText] = useState("");
     const [activeSegmentId, setActiveSegmentId] = useState<string | null>(null);
+    // An empty arrangementId plays the segments in the order of the bank.
+    const [arrangements, setArrangements] = useState<LyricsArrangement[]>([]);
+    const [defaultArrangement, setDefaultArrangement] = useState("");
+    const [arrangementId, setArrangementId] = useState("");
+    const [slideIndex, setSlideIndex] = useState<number | null>(null);
+    const [maxLines, setMaxLines] = useState(4);
     const [isSaving, setIsSaving] = useState(false);
+    const [details, setDetails] = useState<SongDetails>(emptyDetails);
+    const [importReport, setImportReport] = useState<LyricsImportReport | null>(null);
+    const importInputRef = useRef<HTMLInputElement | null>(null);
 
     const textareaRef = useRef<HTMLTextAreaElement | null>(null);
     const dragIndexRef = useRef<number | null>(null);
@@ -99,6 +145,10 @@ export default function LyricsStudio() {
                 segments?: Segment[];
                 settings?: LyricsSettings;
                 activeSegmentId?: string | null;
+                arrangements?: LyricsArrangement[];
+                defaultArrangement?: string;
+                arrangementId?: string;
+                slideIndex?: number | null;
             };
             if (saved.title !== undefined) setTitle(saved.title);
             if (saved.lyrics !== undefined) setLyrics(saved.lyrics);
@@ -106,6 +156,10 @@ export default function LyricsStudio() {
             if (saved.settings !== undefined) setSettings(saved.settings);
             if (saved.activeSongId !== undefined) setActiveSongId(saved.activeSongId);
             if (saved.activeSegmentId !== undefined) setActiveSegmentId(saved.activeSegmentId);
+            if (saved.arrangements !== undefined) setArrangements(saved.arrangements);
+            if (saved.defaultArrangement !== undefined) setDefaultArrangement(saved.defaultArrangement);
+            if (saved.arrangementId !== undefined) setArrangementId(saved.arrangementId);
+            if (saved.slideIndex !== undefined) setSlideIndex(saved.slideIndex);
             autoFollowArmedRef.current = false;
             hasRestoredRef.current = true;
         } catch {
@@ -123,9 +177,13 @@ export default function LyricsStudio() {
             segments,
             settings,
             activeSegmentId,
+            arrangements,
+            defaultArrangement,
+            arrangementId,
+            slideIndex,
         };
         window.localStorage.setItem(LIVE_SNAPSHOT_KEY, JSON.stringify(payload));
-    }, [isLiveLyrics, activeSongId, title, lyrics, segments, settings, activeSegmentId]);
+    }, [isLiveLyrics, activeSongId, title, lyrics, segments, settings, activeSegmentId, arrangements, defaultArrangement, arrangementId, slideIndex]);
 
     useEffect(() => {
         if (!autoFollow) {
@@ -179,26 +237,33 @@ export default function LyricsStudio() {
         setSelectedText("");
     }, [addSegment, selectedText]);
 
-    const handleAutoSegment = useCallback(() => {
-        const blocks = lyrics
-            .split(/\n{2,}/)
-            .map(block => block.trim())
-            .filter(Boolean);
-        if (blocks.length === 0) return;
-        setSegments(prev => {
-            const created = blocks.map((block, index) => {
-                const color = colorPalette[(prev.length + index) % colorPalette.length]?.value ?? colorPalette[0].value;
-                return {
-                    id: buildId(),
-                    title: `Segmento ${prev.length + index + 1}`,
-                    content: block,
-                    kind: "Verso" as SegmentKind,
-                    color,
-                };
-            });
-            return [...created, ...prev];
-        });
-    }, [lyrics]);
+    // The server proposes the segments and the order they are sung in; when
+    // that order repeats or moves them it is kept as an arrangement.
+    const handleAutoSegment = useCallback(async () => {
+        if (!lyrics.trim()) return;
+        let proposal: LyricsSegmentation;
+        try {
+            proposal = await lyricsService.segmentLyrics(lyrics, maxLines);
+        } catch (err) {
+            console.error("Error segmenting lyrics:", err);
+            return;
+        }
+        if (proposal.segments.length === 0) return;
+        const ids = new Map(proposal.segments.map(segment => [segment.id, buildId()]));
+        const created = proposal.segments.map(segment => ({
+            ...segment,
+            id: ids.get(segment.id) ?? buildId(),
+            kind: segment.kind as SegmentKind,
+        }));
+        setSegments(prev => [...created, ...prev]);
+        const sequence = proposal.sequence.map(id => ids.get(id)).filter((id): id is string => Boolean(id));
+        if (sequence.join(" ") === created.map(segment => segment.id).join(" ")) return;
+        const arrangement: LyricsArrangement = { id: buildId(), name: "Auto", sequence };
+        setArrangements(prev => [...prev, arrangement]);
+        if (!defaultArrangement) setDefaultArrangement(arrangement.id);
+        setArrangementId(arrangement.id);
+        setSlideIndex(null);
+    }, [lyrics, maxLines, defaultArrangement]);
 
     const handleUpdateSegment = useCallback((id: string, patch: Partial<Segment>) => {
         setSegments(prev => prev.map(seg => (seg.id === id ? { ...seg, ...patch } : seg)));
@@ -206,8 +271,13 @@ export default function LyricsStudio() {
 
     const handleRemoveSegment = useCallback((id: string) => {
         setSegments(prev => prev.filter(seg => seg.id !== id));
+        setArrangements(prev => prev.map(arrangement => ({
+            ...arrangement,
+            sequence: arrangement.sequence.filter(segmentId => segmentId !== id),
+        })));
         if (activeSegmentId === id) {
             setActiveSegmentId(null);
+            setSlideIndex(null);
         }
     }, [activeSegmentId]);
 
@@ -228,6 +298,60 @@ export default function LyricsStudio() {
         [segments, activeSegmentId]
     );
 
+    const activeArrangement = useMemo(
+        () => arrangements.find(arrangement => arrangement.id === arrangementId) || null,
+        [arrangements, arrangementId]
+    );
+
+    // Slides in the order they are sung, a repeated segment once per repeat.
+    const slides = useMemo(() => {
+        if (!activeArrangement) return segments;
+        const byId = new Map(segments.map(segment => [segment.id, segment]));
+        return activeArrangement.sequence
+            .map(id => byId.get(id))
+            .filter((segment): segment is Segment => Boolean(segment));
+    }, [segments, activeArrangement]);
+
+    const creditLine = useMemo(() => buildCreditLine(details), [details]);
+    const creditsFor = useCallback(
+        (index: number | null) =>
+            index !== null && index === slides.length - 1 && creditLine ? creditLine : undefined,
+        [slides, creditLine]
+    );
+
+    const selectSegment = useCallback((segment: Segment) => {
+        const index = slides.findIndex(slide => slide.id === segment.id);
+        setActiveSegmentId(segment.id);
+        setSlideIndex(index >= 0 ? index : null);
+    }, [slides]);
+
+    const handleSelectArrangement = (id: string) => {
+        setArrangementId(id);
+        setSlideIndex(null);
+    };
+
+    const handleCreateArrangement = () => {
+        const arrangement: LyricsArrangement = {
+            id: buildId(),
+            name: `Arreglo ${arrangements.length + 1}`,
+            sequence: segments.map(segment => segment.id),
+        };
+        setArrangements(prev => [...prev, arrangement]);
+        if (!defaultArrangement) setDefaultArrangement(arrangement.id);
+        handleSelectArrangement(arrangement.id);
+    };
+
+    const handleUpdateArrangement = (id: string, patch: Partial<LyricsArrangement>) => {
+        setArrangements(prev => prev.map(arrangement => (arrangement.id === id ? { ...arrangement, ...patch } : arrangement)));
+    };
+
+    const handleRemoveArrangement = (id: string) => {
+        const rest = arrangements.filter(arrangement => arrangement.id !== id);
+        setArrangements(rest);
+        if (defaultArrangement === id) setDefaultArrangement(rest[0]?.id ?? "");
+        if (arrangementId === id) handleSelectArrangement("");
+    };
+
     const previewScene = useMemo(() => {
         const baseScene = activeSegment
             ? {
@@ -239,6 +363,7 @@ export default function LyricsStudio() {
                     title: title || "Sin título",
                     segmentTitle: activeSegment.title,
                     content: activeSegment.content,
+                    credits: creditsFor(slideIndex),
                 },
                 styles: {
                     fontFamily: settings.fontFamily,
@@ -260,7 +385,7 @@ export default function LyricsStudio() {
                 }
                 : null;
         return baseScene ?? null;
-    }, [activeSegment, title, settings, liveScene]);
+    }, [activeSegment, title, settings, liveScene, creditsFor, slideIndex]);
 
     const { previewScale, previewOffset } = useMemo(() => {
         if (!previewWidth || !previewHeight) {
@@ -277,19 +402,25 @@ export default function LyricsStudio() {
         if (activeSegmentId) return;
         const match = segments.find((segment) => segment.content.trim() === liveScene.payload.content.trim());
         if (match) {
-            setActiveSegmentId(match.id);
+            selectSegment(match);
         }
-    }, [liveScene, segments, activeSegmentId]);
+    }, [liveScene, segments, activeSegmentId, selectSegment]);
 
-    const handleSendSegment = useCallback((segment: Segment, forceLive?: boolean) => {
+    // index is the position of the slide in the arrangement; a segment sent
+    // from the bank goes out as its first appearance.
+    const handleSendSegment = useCallback((segment: Segment, index?: number, forceLive?: boolean) => {
         if (!isConnected) return;
+        const position = index ?? slides.findIndex(slide => slide.id === segment.id);
+        const slidePosition = position >= 0 ? position : null;
         const scene = {
             id: lyricsSceneIdRef.current,
             type: "lyrics" as const,
             payload: {
                 title,
+                songId: activeSongId ?? undefined,
                 segmentTitle: segment.title,
                 content: segment.content,
+                credits: creditsFor(slidePosition),
             },
             styles: {
                 fontFamily: settings.fontFamily,
@@ -306,22 +437,25 @@ export default function LyricsStudio() {
         };
         sendScene(scene, { forceLive: forceLive ?? true });
         setActiveSegmentId(segment.id);
+        setSlideIndex(slidePosition);
         lastPayloadRef.current = JSON.stringify(scene);
         autoFollowArmedRef.current = true;
-    }, [isConnected, sendScene, title, settings]);
+    }, [isConnected, sendScene, title, activeSongId, settings, creditsFor, slides]);
+
+    const currentSlide = () => slideIndex ?? slides.findIndex(slide => slide.id === activeSegmentId);
 
     const handleNextSegment = () => {
-        if (!segments.length) return;
-        const currentIndex = segments.findIndex(seg => seg.id === activeSegmentId);
-        const nextIndex = currentIndex >= 0 ? Math.min(currentIndex + 1, segments.length - 1) : 0;
-        handleSendSegment(segments[nextIndex]);
+        if (!slides.length) return;
+        const currentIndex = currentSlide();
+        const nextIndex = currentIndex >= 0 ? Math.min(currentIndex + 1, slides.length - 1) : 0;
+        handleSendSegment(slides[nextIndex], nextIndex);
     };
 
     const handlePrevSegment = () => {
-        if (!segments.length) return;
-        const currentIndex = segments.findIndex(seg => seg.id === activeSegmentId);
+        if (!slides.length) return;
+        const currentIndex = currentSlide();
         const prevIndex = currentIndex > 0 ? currentIndex - 1 : 0;
-        handleSendSegment(segments[prevIndex]);
+        handleSendSegment(slides[prevIndex], prevIndex);
     };
 
     useEffect(() => {
@@ -333,8 +467,10 @@ export default function LyricsStudio() {
             type: "lyrics" as const,
             payload: {
                 title,
+                songId: activeSongId ?? undefined,
                 segmentTitle: activeSegment.title,
                 content: activeSegment.content,
+                credits: creditsFor(slideIndex),
             },
             styles: {
                 fontFamily: settings.fontFamily,
@@ -353,7 +489,7 @@ export default function LyricsStudio() {
         if (serialized === lastPayloadRef.current) return;
         sendScene(scene, { forceLive: false });
         lastPayloadRef.current = serialized;
-    }, [autoFollow, isConnected, activeSegment, settings, title, sendScene, isLiveLyrics]);
+    }, [autoFollow, isConnected, activeSegment, settings, title, activeSongId, sendScene, isLiveLyrics, creditsFor, slideIndex]);
 
     const handleSaveSong = async () => {
         if (!title.trim()) return;
@@ -364,12 +500,22 @@ export default function LyricsStudio() {
                 title: title.trim(),
                 lyrics,
                 segments,
+                arrangements,
+                defaultArrangement,
                 settings,
+                ...details,
+                authors: splitList(details.authors),
+                tags: splitList(details.tags),
             });
             setActiveSongId(saved.id);
+            setArrangements(saved.arrangements ?? []);
+            setDefaultArrangement(saved.defaultArrangement ?? "");
+            if (!(saved.arrangements ?? []).some(arrangement => arrangement.id === arrangementId)) {
+                handleSelectArrangement(saved.defaultArrangement ?? "");
+            }
             setSongs(prev => {
                 const existing = prev.filter(song => song.id !== saved.id);
-                return [{ id: saved.id, title: saved.title, updatedAt: saved.updatedAt }, ...existing];
+                return [{ id: saved.id, title: saved.title, ccli: saved.ccli, key: saved.key, updatedAt: saved.updatedAt }, ...existing];
             });
         } finally {
             setIsSaving(false);
@@ -382,7 +528,19 @@ export default function LyricsStudio() {
         setTitle(song.title);
         setLyrics(song.lyrics);
         setSegments(song.segments as Segment[]);
+        setArrangements(song.arrangements ?? []);
+        setDefaultArrangement(song.defaultArrangement ?? "");
+        setArrangementId(song.defaultArrangement ?? "");
+        setSlideIndex(null);
         setSettings(song.settings ?? defaultSettings);
+        setDetails({
+            authors: (song.authors ?? []).join(", "),
+            copyright: song.copyright ?? "",
+            ccli: song.ccli ?? "",
+            key: song.key ?? "",
+            tempo: song.tempo ?? 0,
+            tags: (song.tags ?? []).join(", "),
+        });
         setActiveSegmentId(null);
         lastPayloadRef.current = "";
         autoFollowArmedRef.current = false;
@@ -393,7 +551,12 @@ export default function LyricsStudio() {
         setTitle("");
         setLyrics("");
         setSegments([]);
+        setArrangements([]);
+        setDefaultArrangement("");
+        setArrangementId("");
+        setSlideIndex(null);
         setSettings(defaultSettings);
+        setDetails(emptyDetails);
         setSelectedText("");
         setActiveSegmentId(null);
         lastPayloadRef.current = "";
@@ -407,6 +570,17 @@ export default function LyricsStudio() {
         handleNewSong();
     };
 
+    const handleImportSongs = async (file?: File) => {
+        if (!file) return;
+        try {
+            const report = await lyricsService.importSongs(file);
+            setImportReport(report);
+            setSongs(await lyricsService.listSongs());
+        } finally {
+            if (importInputRef.current) importInputRef.current.value = "";
+        }
+    };
+
     return (
         <Card className="glass-panel w-full min-h-[calc(100vh-220px)]">
             <CardHeader className="pb-3">
@@ -469,6 +643,27 @@ export default function LyricsStudio() {
                                 Eliminar
                             </Button>
                         </div>
+                        <input
+                            ref={importInputRef}
+                            type="file"
+                            accept=".xml,.zip,.cho,.chordpro,.chopro,.crd,.pro"
+                            className="hidden"
+                            onChange={(e) => handleImportSongs(e.target.files?.[0])}
+                        />
+                        <Button
+                            size="sm"
+                            variant="outline"
+                            onClick={() => importInputRef.current?.click()}
+                            className="mt-2 h-8 w-full text-xs"
+                        >
+                            <Upload className="mr-2 h-4 w-4" />
+                            Importar OpenLyrics / ChordPro
+                        </Button>
+                        {importReport && (
+                            <p className="mt-2 text-xs text-slate-500">
+                                {importReport.imported} importadas, {importReport.merged} fusionadas, {importReport.skipped} omitidas.
+                            </p>
+                        )}
                     </AccordionSection>
                     <AccordionSection title="Letra principal" icon={<PenLine className="h-4 w-4" />} defaultOpen>
                         <Input
@@ -508,6 +703,17 @@ export default function LyricsStudio() {
                                 <Wand2 className="mr-2 h-4 w-4" />
                                 Auto-segmentar
                             </Button>
+                            <label className="flex items-center gap-1 text-xs text-slate-500">
+                                Líneas
+                                <Input
+                                    type="number"
+                                    min={1}
+                                    max={20}
+                                    value={maxLines}
+                                    onChange={(e) => setMaxLines(Math.min(20, Math.max(1, Number(e.target.value) || 4)))}
+                                    className="h-8 w-16 text-xs"
+                                />
+                            </label>
                             {selectedText && (
                                 <div className="flex items-center gap-2">
                                     <span className="text-xs text-slate-500">
@@ -529,6 +735,48 @@ export default function LyricsStudio() {
                             )}
                         </div>
                     </AccordionSection>
+                    <AccordionSection title="Detalles" icon={<Tags className="h-4 w-4" />}>
+                        <div className="mt-3 grid grid-cols-2 gap-2">
+                            <Input
+                                value={details.authors}
+                                onChange={(e) => setDetails(prev => ({ ...prev, authors: e.target.value }))}
+                                placeholder="Autores (separados por coma)"
+                                className="col-span-2"
+                            />
+                            <Input
+                                value={details.copyright}
+                                onChange={(e) => setDetails(prev => ({ ...prev, copyright: e.target.value }))}
+                                placeholder="Copyright"
+                                className="col-span-2"
+                            />
+                            <Input
+                                value={details.ccli}
+                                onChange={(e) => setDetails(prev => ({ ...prev, ccli: e.target.value.trim() }))}
+                                placeholder="N.º CCLI"
+                            />
+                            <Input
+                                value={details.key}
+                                onChange={(e) => setDetails(prev => ({ ...prev, key: e.target.value.trim() }))}
+                                placeholder="Tono (G, F#m)"
+                            />
+                            <Input
+                                type="number"
+                                min={0}
+                                max={400}
+                                value={details.tempo || ""}
+                                onChange={(e) => setDetails(prev => ({ ...prev, tempo: Number(e.target.value) || 0 }))}
+                                placeholder="BPM"
+                            />
+                            <Input
+                                value={details.tags}
+                                onChange={(e) => setDetails(prev => ({ ...prev, tags: e.target.value }))}
+                                placeholder="Etiquetas"
+                            />
+                        </div>
+                        {creditLine && (
+                            <p className="mt-2 text-xs text-slate-500">Última diapositiva: {creditLine}</p>
+                        )}
+                    </AccordionSection>
                 </section>
 
                 <section className="flex flex-col gap-4">
@@ -559,7 +807,7 @@ export default function LyricsStudio() {
                                     draggable
                                     onClick={() => {
                                         autoFollowArmedRef.current = false;
-                                        setActiveSegmentId(segment.id);
+                                        selectSegment(segment);
                                     }}
                                     onDragStart={() => (dragIndexRef.current = index)}
                                     onDragEnd={() => {
@@ -635,6 +883,119 @@ export default function LyricsStudio() {
                         </div>
                         </div>
                     </AccordionSection>
+                    <AccordionSection title="Arreglos" icon={<ListOrdered className="h-4 w-4" />}>
+                        <p className="mt-2 text-xs text-slate-500">
+                            Cada arreglo repite los segmentos en el orden que se cantan ese día.
+                        </p>
+                        <div className="mt-3 flex flex-wrap items-center gap-2">
+                            <button
+                                type="button"
+                                onClick={() => handleSelectArrangement("")}
+                                className={`rounded-full px-2 py-1 text-[11px] ${
+                                    arrangementId === "" ? "bg-slate-900 text-white" : "bg-slate-100 text-slate-500"
+                                }`}
+                            >
+                                Orden del banco
+                            </button>
+                            {arrangements.map((arrangement) => (
+                                <button
+                                    key={arrangement.id}
+                                    type="button"
+                                    onClick={() => handleSelectArrangement(arrangement.id)}
+                                    className={`flex items-center gap-1 rounded-full px-2 py-1 text-[11px] ${
+                                        arrangementId === arrangement.id ? "bg-slate-900 text-white" : "bg-slate-100 text-slate-500"
+                                    }`}
+                                >
+                                    {defaultArrangement === arrangement.id && <Star className="h-3 w-3" />}
+                                    {arrangement.name || "Sin nombre"}
+                                </button>
+                            ))}
+                            <Button
+                                type="button"
+                                size="sm"
+                                variant="outline"
+                                className="h-7 px-2 text-[11px]"
+                                onClick={handleCreateArrangement}
+                                disabled={segments.length === 0}
+                            >
+                                <Plus className="mr-1 h-3 w-3" />
+                                Nuevo
+                            </Button>
+                        </div>
+                        {activeArrangement && (
+                            <div className="mt-3 flex flex-col gap-3">
+                                <div className="flex items-center gap-2">
+                                    <Input
+                                        value={activeArrangement.name}
+                                        onChange={(e) => handleUpdateArrangement(activeArrangement.id, { name: e.target.value })}
+                                        placeholder="Nombre del arreglo"
+                                        className="h-8 text-xs"
+                                    />
+                                    <Button
+                                        type="button"
+                                        size="sm"
+                                        variant="outline"
+                                        className="h-8 px-2 text-[11px]"
+                                        onClick={() => setDefaultArrangement(activeArrangement.id)}
+                                        disabled={defaultArrangement === activeArrangement.id}
+                                    >
+                                        <Star className="mr-1 h-3 w-3" />
+                                        Predeterminado
+                                    </Button>
+                                    <button
+                                        type="button"
+                                        onClick={() => handleRemoveArrangement(activeArrangement.id)}
+                                        className="rounded-full p-1 text-slate-400 hover:bg-slate-100"
+                                        aria-label="Eliminar arreglo"
+                                    >
+                                        <Trash2 className="h-4 w-4" />
+                                    </button>
+                                </div>
+                                <div className="flex flex-wrap gap-2">
+                                    {slides.length === 0 && (
+                                        <p className="text-xs text-slate-400">Agrega segmentos al arreglo.</p>
+                                    )}
+                                    {slides.map((slide, index) => (
+                                        <span
+                                            key={`${slide.id}-${index}`}
+                                            className={`flex items-center gap-1 rounded-full border px-2 py-0.5 text-[11px] ${slide.color} ${
+                                                slideIndex === index ? "ring-2 ring-emerald-300" : ""
+                                            }`}
+                                        >
+                                            {slide.title}
+                                            <button
+                                                type="button"
+                                                onClick={() => {
+                                                    handleUpdateArrangement(activeArrangement.id, {
+                                                        sequence: slides.filter((_, position) => position !== index).map(item => item.id),
+                                                    });
+                                                    setSlideIndex(null);
+                                                }}
+                                                aria-label="Quitar del arreglo"
+                                            >
+                                                <X className="h-3 w-3" />
+                                            </button>
+                                        </span>
+                                    ))}
+                                </div>
+                                <div className="flex flex-wrap gap-2">
+                                    {segments.map((segment) => (
+                                        <button
+                                            key={segment.id}
+                                            type="button"
+                                            onClick={() => handleUpdateArrangement(activeArrangement.id, {
+                                                sequence: [...slides.map(item => item.id), segment.id],
+                                            })}
+                                            className="flex items-center gap-1 rounded-full bg-slate-100 px-2 py-0.5 text-[11px] text-slate-500 hover:bg-slate-200"
+                                        >
+                                            <Plus className="h-3 w-3" />
+                                            {segment.title}
+                                        </button>
+                                    ))}
+                                </div>
+                            </div>
+                        )}
+                    </AccordionSection>
                 </section>
 
                 <section className="flex flex-col gap-4">
@@ -671,7 +1032,7 @@ export default function LyricsStudio() {
                                 <Button size="sm" variant="outline" className="h-8 w-8 p-0" onClick={handlePrevSegment} disabled={!isConnected || !activeSegment}>
                                     <ChevronLeft className="h-4 w-4" />
                                 </Button>
-                                <Button size="sm" variant="outline" className="h-8 w-8 p-0" onClick={handleNextSegment} disabled={!isConnected || segments.length === 0}>
+                                <Button size="sm" variant="outline" className="h-8 w-8 p-0" onClick={handleNextSegment} disabled={!isConnected || slides.length === 0}>
                                     <ChevronRight className="h-4 w-4" />
                                 </Button>
                             </div>
@@ -150,6 +150,11 @@ function VerseSceneView({ scene, playMedia }: { scene: VerseScene; playMedia: bo
               >
                 {scene.payload.text}
               </p>
+              {scene.payload.translation && (
+                <p className="mt-6 text-sm opacity-60" style={{ color: styles.referenceColor || "#ffffff" }}>
+                  {scene.payload.translation}
+                </p>
+              )}
             </div>
           )}
         </div>
@@ -215,6 +220,11 @@ function VerseSceneView({ scene, playMedia }: { scene: VerseScene; playMedia: bo
                 >
                   {scene.payload.text}
                 </p>
+                {scene.payload.translation && (
+                  <p className="mt-6 text-sm opacity-60" style={{ color: styles.referenceColor || "#ffffff" }}>
+                    {scene.payload.translation}
+                  </p>
+                )}
               </div>
             </div>
           )}
@@ -247,6 +257,9 @@ function LyricsSceneView({ scene }: { scene: LyricsScene }) {
         <p className="mt-6 whitespace-pre-line" style={{ fontSize: styles.fontSize || 52 }}>
           {scene.payload.content}
         </p>
+        {scene.payload.credits && (
+          <p className="mt-8 text-sm opacity-60">{scene.payload.credits}</p>
+        )}
       </div>
     </motion.div>
   );
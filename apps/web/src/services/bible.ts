@@ -11,13 +11,24 @@ import { ensureAbsoluteUrl } from './mediaUrls';
 
 interface Verse {
     index: number;
+    last?: number;
     text: string;
 }
 
+export interface Translation {
+    id: number;
+    name: string;
+    abbreviation: string;
+    language: string;
+    license?: string;
+    attribution: string;
+}
+
 interface Chapter {
     name: string;
     research: string;
     verses: Verse[];
+    translation?: Translation;
 }
 
 export interface VerseMatch {
@@ -25,9 +36,102 @@ export interface VerseMatch {
     chapter: number;
     verse: number;
     text: string;
+    snippet?: string;
+}
+
+export interface SearchFacet {
+    book: string;
+    name: string;
+    count: number;
+}
+
+export interface SearchSuggestion {
+    term: string;
+    suggestions: string[];
+}
+
+export interface SearchPage {
+    total: number;
+    offset: number;
+    limit: number;
+    nextOffset: number | null;
+    results: VerseMatch[];
+    facets: SearchFacet[];
+    approximate: boolean;
+    suggestions?: SearchSuggestion[];
+}
+
+export interface BookInfo {
+    book: string;
+    name: string;
+    position: number;
+    abbreviation: string;
+    osis: string;
+    usfm: string;
+    aliases: string[];
+    testament: 'at' | 'nt';
+    genre: string;
+    genreName: string;
+    chapters: number;
+    verses: number[];
+}
+
+export interface VerseOfTheDay {
+    date: string;
+    reference: string;
+    book: string;
+    chapter: number;
+    verses: Verse[];
 }
 
 export const bibleService = {
+    getVerseOfTheDay: async (version: number = 1, date?: string): Promise<VerseOfTheDay> => {
+        try {
+            const apiBibleUrl = await getApiBibleUrl();
+            const response = await axios.get<VerseOfTheDay>(`${apiBibleUrl}/verse-of-the-day`, {
+                params: date ? { version, date } : { version },
+                headers: {
+                    'Content-Type': 'application/json',
+                    'Accept': 'application/json',
+                }
+            });
+            return response.data;
+        } catch (error) {
+            if (axios.isAxiosError(error)) {
+                console.error('Axios error:', error.response?.data || error.message);
+                throw new Error(`Failed to fetch verse of the day: ${error.message}`);
+            } else {
+                console.error('Error fetching verse of the day:', error);
+                throw new Error('An unexpected error occurred while fetching the verse of the day');
+            }
+        }
+    },
+    listBooks: async (version: number = 1): Promise<BookInfo[]> => {
+        try {
+            const apiBibleUrl = await getApiBibleUrl();
+            const response = await axios.get<BookInfo[]>(`${apiBibleUrl}/books`, {
+                params: { version },
+                headers: {
+                    'Content-Type': 'application/json',
+                    'Accept': 'application/json',
+                }
+            });
+
+            if (!Array.isArray(response.data)) {
+                throw new Error('Invalid book data received from server');
+            }
+
+            return response.data;
+        } catch (error) {
+            if (axios.isAxiosError(error)) {
+                console.error('Axios error:', error.response?.data || error.message);
+                throw new Error(`Failed to list books: ${error.message}`);
+            } else {
+                console.error('Error listing books:', error);
+                throw new Error('An unexpected error occurred while listing books');
+            }
+        }
+    },
     getChapter: async (book: string, chapter: number, version: number, offset: number = 0, limit: number = 0): Promise<Chapter> => {
         try {
             const apiBibleUrl = await getApiBibleUrl();
@@ -47,6 +151,7 @@ export const bibleService = {
                 name: response.data.name,
                 research: response.data.research,
                 verses: response.data.verses,
+                translation: response.data.translation,
             };
 
 
@@ -66,10 +171,14 @@ export const bibleService = {
         }
     },
     searchVerses: async (query: string, version: number = 1, limit: number = 6): Promise<VerseMatch[]> => {
+        const page = await bibleService.searchVersesPage(query, version, limit);
+        return page.results;
+    },
+    searchVersesPage: async (query: string, version: number = 1, limit: number = 6, offset: number = 0): Promise<SearchPage> => {
         try {
             const searchUrl = await getApiBibleSearchUrl();
-            const response = await axios.get<VerseMatch[]>(searchUrl, {
-                params: { q: query, version, limit },
+            const response = await axios.get<SearchPage>(searchUrl, {
+                params: { q: query, version, limit, offset },
                 headers: {
                     'Content-Type': 'application/json',
                     'Accept': 'application/json',
@@ -77,7 +186,7 @@ export const bibleService = {
                 }
             });
 
-            if (!Array.isArray(response.data)) {
+            if (!response.data || !Array.isArray(response.data.results)) {
                 throw new Error('Invalid search data received from server');
             }
 
@@ -0,0 +1,35 @@
+import axios from "axios";
+import { getApiBookAliasesUrl } from "./endpoints";
+
+export interface BookAlias {
+    id: number;
+    book: string;
+    alias: string;
+    language: string;
+    custom: boolean;
+}
+
+export interface BookAliasPayload {
+    book: string;
+    alias: string;
+    language?: string;
+}
+
+const bookAliasesService = {
+    listAliases: async (book?: string): Promise<BookAlias[]> => {
+        const aliasesUrl = await getApiBookAliasesUrl();
+        const response = await axios.get<BookAlias[]>(aliasesUrl, { params: { book } });
+        return response.data;
+    },
+    addAlias: async (payload: BookAliasPayload): Promise<BookAlias> => {
+        const aliasesUrl = await getApiBookAliasesUrl();
+        const response = await axios.post<BookAlias>(aliasesUrl, payload);
+        return response.data;
+    },
+    deleteAlias: async (id: number): Promise<void> => {
+        const aliasesUrl = await getApiBookAliasesUrl();
+        await axios.delete(`${aliasesUrl}/${id}`);
+    },
+};
+
+export default bookAliasesService;
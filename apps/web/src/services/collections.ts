@@ -0,0 +1,82 @@
+import axios from "axios";
+import { getApiCollectionsUrl } from "./endpoints";
+
+export interface CollectionItem {
+    reference: string;
+    note: string;
+}
+
+export interface VerseCollection {
+    id: string;
+    name: string;
+    description: string;
+    items: CollectionItem[];
+    createdAt: string;
+    updatedAt: string;
+}
+
+export interface VerseCollectionSummary {
+    id: string;
+    name: string;
+    items: number;
+    updatedAt: string;
+}
+
+export interface VerseCollectionPayload {
+    id?: string;
+    name: string;
+    description?: string;
+    items: CollectionItem[];
+}
+
+export interface PassageChapter {
+    book: string;
+    chapter: number;
+    name: string;
+    research?: string;
+    verses: { index: number; text: string; research?: string }[];
+}
+
+export interface CollectionPassage extends CollectionItem {
+    passage?: { reference: string; chapters: PassageChapter[] };
+    error?: string;
+}
+
+export interface CollectionText {
+    id: string;
+    name: string;
+    description: string;
+    version: number;
+    items: CollectionPassage[];
+}
+
+const collectionsService = {
+    listCollections: async (): Promise<VerseCollectionSummary[]> => {
+        const collectionsUrl = await getApiCollectionsUrl();
+        const response = await axios.get<VerseCollectionSummary[]>(collectionsUrl);
+        return response.data;
+    },
+    getCollection: async (id: string): Promise<VerseCollection> => {
+        const collectionsUrl = await getApiCollectionsUrl();
+        const response = await axios.get<VerseCollection>(`${collectionsUrl}/${id}`);
+        return response.data;
+    },
+    getCollectionText: async (id: string, version?: number): Promise<CollectionText> => {
+        const collectionsUrl = await getApiCollectionsUrl();
+        const response = await axios.get<CollectionText>(`${collectionsUrl}/${id}/text`, {
+            params: version ? { version } : undefined,
+        });
+        return response.data;
+    },
+    saveCollection: async (payload: VerseCollectionPayload): Promise<VerseCollection> => {
+        const collectionsUrl = await getApiCollectionsUrl();
+        const response = await axios.post<VerseCollection>(collectionsUrl, payload);
+        return response.data;
+    },
+    deleteCollection: async (id: string): Promise<void> => {
+        const collectionsUrl = await getApiCollectionsUrl();
+        await axios.delete(`${collectionsUrl}/${id}`);
+    },
+};
+
+export default collectionsService;
@@ -33,6 +33,22 @@ export async function getApiCoversUrl() {
   return `${await getApiBaseUrl()}/v1/covers`;
 }
 
+export async function getApiCollectionsUrl() {
+  return `${await getApiBaseUrl()}/v1/collections`;
+}
+
+export async function getApiPlansUrl() {
+  return `${await getApiBaseUrl()}/v1/plans`;
+}
+
+export async function getApiTopicsUrl() {
+  return `${await getApiBaseUrl()}/v1/topics`;
+}
+
+export async function getApiBookAliasesUrl() {
+  return `${await getApiBaseUrl()}/v1/bible/aliases`;
+}
+
 export async function getWebSocketUrl() {
   return await getWsUrl();
 }
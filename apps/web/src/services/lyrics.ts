@@ -5,10 +5,17 @@ export interface LyricsSegment {
     id: string;
     title: string;
     content: string;
+    chords?: string;
     kind: string;
     color: string;
 }
 
+export interface LyricsArrangement {
+    id: string;
+    name: string;
+    sequence: string[];
+}
+
 export interface LyricsSettings {
     fontFamily: string;
     fontSize: number;
@@ -23,7 +30,16 @@ export interface LyricsSong {
     title: string;
     lyrics: string;
     segments: LyricsSegment[];
+    arrangements: LyricsArrangement[];
+    defaultArrangement: string;
     settings: LyricsSettings;
+    authors: string[];
+    copyright: string;
+    ccli: string;
+    key: string;
+    tempo: number;
+    tags: string[];
+    credits?: string;
     createdAt: string;
     updatedAt: string;
 }
@@ -31,6 +47,11 @@ export interface LyricsSong {
 export interface LyricsSongSummary {
     id: string;
     title: string;
+    authors?: string[];
+    ccli?: string;
+    key?: string;
+    tempo?: number;
+    tags?: string[];
     updatedAt: string;
 }
 
@@ -39,13 +60,79 @@ export interface LyricsSongPayload {
     title: string;
     lyrics: string;
     segments: LyricsSegment[];
+    arrangements?: LyricsArrangement[];
+    defaultArrangement?: string;
     settings: LyricsSettings;
+    authors?: string[];
+    copyright?: string;
+    ccli?: string;
+    key?: string;
+    tempo?: number;
+    tags?: string[];
+}
+
+export interface LyricsSlides {
+    songId: string;
+    arrangement?: string;
+    name?: string;
+    slides: LyricsSegment[];
+    credits?: string;
+}
+
+export interface LyricsSegmentation {
+    segments: LyricsSegment[];
+    sequence: string[];
+}
+
+export interface LyricsSongFilters {
+    q?: string;
+    key?: string;
+    tag?: string;
+    tempoMin?: number;
+    tempoMax?: number;
+    sort?: "updated" | "title" | "key" | "tempo";
+    order?: "asc" | "desc";
+}
+
+export interface LyricsTag {
+    name: string;
+    songs: number;
+}
+
+export type LyricsFileFormat = "openlyrics" | "chordpro";
+
+export interface ChordChartOptions {
+    format?: "chordpro" | "text";
+    key?: string;
+    transpose?: number;
+    capo?: number;
+    arrangement?: string;
+}
+
+export interface LyricsImportItem {
+    file: string;
+    title?: string;
+    id?: string;
+    status: "imported" | "merged" | "skipped";
+    reason?: string;
+}
+
+export interface LyricsImportReport {
+    imported: number;
+    merged: number;
+    skipped: number;
+    songs: LyricsImportItem[];
 }
 
 const lyricsService = {
-    listSongs: async (): Promise<LyricsSongSummary[]> => {
+    listSongs: async (filters: LyricsSongFilters = {}): Promise<LyricsSongSummary[]> => {
         const lyricsUrl = await getApiLyricsUrl();
-        const response = await axios.get<LyricsSongSummary[]>(lyricsUrl);
+        const response = await axios.get<LyricsSongSummary[]>(lyricsUrl, { params: filters });
+        return response.data;
+    },
+    listTags: async (): Promise<LyricsTag[]> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        const response = await axios.get<LyricsTag[]>(`${lyricsUrl}/tags`);
         return response.data;
     },
     getSong: async (id: string): Promise<LyricsSong> => {
@@ -53,6 +140,13 @@ const lyricsService = {
         const response = await axios.get<LyricsSong>(`${lyricsUrl}/${id}`);
         return response.data;
     },
+    getSlides: async (id: string, arrangement?: string): Promise<LyricsSlides> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        const response = await axios.get<LyricsSlides>(`${lyricsUrl}/${id}/slides`, {
+            params: arrangement ? { arrangement } : undefined,
+        });
+        return response.data;
+    },
     saveSong: async (payload: LyricsSongPayload): Promise<LyricsSong> => {
         const lyricsUrl = await getApiLyricsUrl();
         const response = await axios.post<LyricsSong>(lyricsUrl, payload);
@@ -62,6 +156,34 @@ const lyricsService = {
         const lyricsUrl = await getApiLyricsUrl();
         await axios.delete(`${lyricsUrl}/${id}`);
     },
+    importSongs: async (file: File, existing: "merge" | "skip" = "merge", format?: LyricsFileFormat): Promise<LyricsImportReport> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        const formData = new FormData();
+        formData.append("file", file);
+        if (format) formData.append("format", format);
+        formData.append("existing", existing);
+        const response = await axios.post<LyricsImportReport>(`${lyricsUrl}/import`, formData, {
+            headers: { "Content-Type": "multipart/form-data" },
+        });
+        return response.data;
+    },
+    segmentLyrics: async (text: string, maxLines?: number): Promise<LyricsSegmentation> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        const response = await axios.post<LyricsSegmentation>(`${lyricsUrl}/segment`, { text, maxLines });
+        return response.data;
+    },
+    getExportUrl: async (id?: string, format: LyricsFileFormat = "openlyrics"): Promise<string> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        return id ? `${lyricsUrl}/${id}/export?format=${format}` : `${lyricsUrl}/export?format=${format}`;
+    },
+    getChords: async (id: string, options: ChordChartOptions = {}): Promise<string> => {
+        const lyricsUrl = await getApiLyricsUrl();
+        const response = await axios.get<string>(`${lyricsUrl}/${id}/chords`, {
+            params: options,
+            responseType: "text",
+        });
+        return response.data;
+    },
 };
 
 export default lyricsService;
@@ -0,0 +1,88 @@
+import axios from "axios";
+import { getApiPlansUrl } from "./endpoints";
+import type { PassageChapter } from "./collections";
+
+export interface ReadingPlanDay {
+    day: number;
+    readings: string[];
+}
+
+export interface ReadingPlan {
+    id: string;
+    name: string;
+    description: string;
+    startDate: string;
+    days: number;
+    schedule: ReadingPlanDay[];
+    createdAt: string;
+    updatedAt: string;
+}
+
+export interface ReadingPlanSummary {
+    id: string;
+    name: string;
+    startDate: string;
+    days: number;
+    updatedAt: string;
+}
+
+export interface PlanReading {
+    reference: string;
+    passage?: { reference: string; chapters: PassageChapter[] };
+    error?: string;
+}
+
+export interface ReadingPlanToday {
+    planId: string;
+    name: string;
+    date: string;
+    day: number;
+    days: number;
+    version: number;
+    readings: PlanReading[];
+}
+
+export interface ImportPlanOptions {
+    id?: string;
+    name?: string;
+    description?: string;
+    startDate?: string;
+}
+
+const plansService = {
+    listPlans: async (): Promise<ReadingPlanSummary[]> => {
+        const plansUrl = await getApiPlansUrl();
+        const response = await axios.get<ReadingPlanSummary[]>(plansUrl);
+        return response.data;
+    },
+    getPlan: async (id: string): Promise<ReadingPlan> => {
+        const plansUrl = await getApiPlansUrl();
+        const response = await axios.get<ReadingPlan>(`${plansUrl}/${id}`);
+        return response.data;
+    },
+    getToday: async (id: string, version?: number, date?: string): Promise<ReadingPlanToday> => {
+        const plansUrl = await getApiPlansUrl();
+        const response = await axios.get<ReadingPlanToday>(`${plansUrl}/${id}/today`, {
+            params: { version, date },
+        });
+        return response.data;
+    },
+    importPlan: async (file: File, options: ImportPlanOptions = {}): Promise<ReadingPlan> => {
+        const plansUrl = await getApiPlansUrl();
+        const formData = new FormData();
+        formData.append("file", file);
+        Object.entries(options).forEach(([key, value]) => {
+            if (value) formData.append(key, value);
+        });
+        const response = await axios.post<ReadingPlan>(`${plansUrl}/import`, formData, {
+            headers: { "Content-Type": "multipart/form-data" },
+        });
+        return response.data;
+    },
+    deletePlan: async (id: string): Promise<void> => {
+        const plansUrl = await getApiPlansUrl();
+        await axios.delete(`${plansUrl}/${id}`);
+    },
+};
+
+export default plansService;
@@ -0,0 +1,42 @@
+import axios from "axios";
+import { getApiTopicsUrl } from "./endpoints";
+
+export interface Topic {
+    id: number;
+    name: string;
+    references: number;
+}
+
+export interface TopicPassage {
+    reference: string;
+    verses: { index: number; text: string; research?: string }[];
+}
+
+export interface TopicVerses {
+    id: number;
+    name: string;
+    total: number;
+    offset: number;
+    limit: number;
+    nextOffset: number | null;
+    passages: TopicPassage[];
+}
+
+const topicsService = {
+    searchTopics: async (query: string, limit?: number): Promise<Topic[]> => {
+        const topicsUrl = await getApiTopicsUrl();
+        const response = await axios.get<Topic[]>(`${topicsUrl}/search`, {
+            params: { q: query, limit },
+        });
+        return response.data;
+    },
+    getTopicVerses: async (id: number, version?: number, offset?: number, limit?: number): Promise<TopicVerses> => {
+        const topicsUrl = await getApiTopicsUrl();
+        const response = await axios.get<TopicVerses>(`${topicsUrl}/${id}/verses`, {
+            params: { version, offset, limit },
+        });
+        return response.data;
+    },
+};
+
+export default topicsService;
@@ -79,8 +79,10 @@ export interface VersePreferences {
 
 export interface LyricsPayload {
   title: string;
+  songId?: string;
   segmentTitle?: string;
   content: string;
+  credits?: string;
 }
 
 export interface LyricsStyles {
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/bibleimport"
+	"services/api/internal/config"
+	"services/api/internal/infrastructure"
+)
+
+const importBibleCommand = "import-bible"
+
+// runImportBible handles `ionic-x import-bible --file <path>` and returns the
+// process exit code.
+func runImportBible(args []string) int {
+	flags := flag.NewFlagSet(importBibleCommand, flag.ContinueOnError)
+	path := flags.String("file", "", "Bible file, zip bundle or folder of USFM/USX books to import")
+	format := flags.String("format", "", "Import format (default: detected from the file extension)")
+	name := flags.String("name", "", "Version name (default: read from the file)")
+	abbreviation := flags.String("abbreviation", "", "Version abbreviation, e.g. RVR1960")
+	language := flags.String("language", "", "Version language code, e.g. es")
+	license := flags.String("license", "", "Copyright notice of the version")
+	attribution := flags.String("attribution", "", "Short copyright line projected with the verses")
+	versification := flags.String("versification", "", "Verse numbering scheme, e.g. lxx (default: standard)")
+	validateOnly := flags.Bool("validate-only", false, "Report missing chapters and verses without importing")
+	force := flags.Bool("force", false, "Import even when books, chapters or verses are missing")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		fmt.Fprintln(os.Stderr, "--file is required")
+		flags.Usage()
+		return 2
+	}
+	if *format == "" {
+		*format = bibleimport.DetectFormat(*path)
+	}
+	meta := bibleimport.Metadata{
+		Name:          *name,
+		Abbreviation:  *abbreviation,
+		Language:      *language,
+		License:       *license,
+		Attribution:   *attribution,
+		Versification: *versification,
+	}
+
+	bible, err := parseBibleFile(*path, *format, meta)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot parse %s: %v\n", *path, err)
+		return 1
+	}
+
+	cfg := config.Load()
+	db, err := openDatabase(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer db.Close()
+	if err := runMigrations(db); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	action := actions.NewBibleAction(infrastructure.NewBibleRepo(db))
+	result, err := action.ImportBible(ctx, *bible, entities.BibleImportOptions{ValidateOnly: *validateOnly, Force: *force})
+	if errors.Is(err, actions.ErrIncompleteBible) {
+		printImportValidation(result.Validation)
+		fmt.Fprintln(os.Stderr, "import aborted: the bible is incomplete; use --force to import it anyway")
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		return 1
+	}
+
+	printImportValidation(result.Validation)
+	if result.Bible != nil {
+		fmt.Printf("imported %s (id=%d) books=%d verses=%d into %s\n",
+			result.Bible.Name, result.Bible.ID, result.Bible.Books, result.Bible.Verses, cfg.SQLite.Path)
+	}
+	return 0
+}
+
+func printImportValidation(validation entities.ImportValidation) {
+	if len(validation.MissingBooks) > 0 {
+		fmt.Printf("missing books: %s\n", strings.Join(validation.MissingBooks, ", "))
+	}
+	for _, chapter := range validation.MissingChapters {
+		fmt.Printf("missing chapter: %s %d\n", chapter.Book, chapter.Chapter)
+	}
+	for _, verses := range validation.MissingVerses {
+		fmt.Printf("missing verses: %s %d: %v\n", verses.Book, verses.Chapter, verses.Verses)
+	}
+}
+
+func parseBibleFile(path string, format string, meta bibleimport.Metadata) (*entities.BibleImport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return bibleimport.ParseDir(path, meta)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return bibleimport.Parse(format, file, meta)
+}
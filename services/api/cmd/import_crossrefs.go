@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"services/api/internal/actions"
+	"services/api/internal/config"
+	"services/api/internal/crossref"
+	"services/api/internal/infrastructure"
+)
+
+const importCrossRefsCommand = "import-crossrefs"
+
+// runImportCrossRefs handles `ionic-x import-crossrefs --file <path>` and
+// returns the process exit code. The file replaces any cross references
+// loaded before.
+func runImportCrossRefs(args []string) int {
+	flags := flag.NewFlagSet(importCrossRefsCommand, flag.ContinueOnError)
+	path := flags.String("file", "", "Cross-reference file, e.g. cross_references.txt from openbible.info")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		fmt.Fprintln(os.Stderr, "--file is required")
+		flags.Usage()
+		return 2
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	refs, skipped, err := crossref.Parse(file)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot parse %s: %v\n", *path, err)
+		return 1
+	}
+
+	cfg := config.Load()
+	db, err := openDatabase(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer db.Close()
+	if err := runMigrations(db); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	action := actions.NewBibleAction(infrastructure.NewBibleRepo(db))
+	imported, err := action.ImportCrossReferences(ctx, refs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("imported %d cross references (skipped %d) into %s\n", imported, skipped, cfg.SQLite.Path)
+	return 0
+}
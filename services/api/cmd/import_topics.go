@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"services/api/internal/actions"
+	"services/api/internal/config"
+	"services/api/internal/infrastructure"
+	"services/api/internal/topics"
+)
+
+const importTopicsCommand = "import-topics"
+
+// runImportTopics handles `ionic-x import-topics --file <path> [--format
+// csv|json]` and returns the process exit code. The file replaces the
+// topical index loaded before.
+func runImportTopics(args []string) int {
+	flags := flag.NewFlagSet(importTopicsCommand, flag.ContinueOnError)
+	path := flags.String("file", "", "Topical index, e.g. Nave's Topical Bible as CSV or JSON")
+	format := flags.String("format", "", "csv or json (default: from the file extension)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		fmt.Fprintln(os.Stderr, "--file is required")
+		flags.Usage()
+		return 2
+	}
+	if *format == "" {
+		*format = topics.DetectFormat(*path)
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	index, skipped, err := topics.Parse(*format, file)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot parse %s: %v\n", *path, err)
+		return 1
+	}
+
+	cfg := config.Load()
+	db, err := openDatabase(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer db.Close()
+	if err := runMigrations(db); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	action := actions.NewTopicAction(infrastructure.NewTopicRepo(db), infrastructure.NewBibleRepo(db))
+	imported, err := action.ImportTopics(ctx, index)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("imported %d topics (skipped %d references) into %s\n", imported, skipped, cfg.SQLite.Path)
+	return 0
+}
@@ -37,6 +37,16 @@ const (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == importBibleCommand {
+		os.Exit(runImportBible(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == importCrossRefsCommand {
+		os.Exit(runImportCrossRefs(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == importTopicsCommand {
+		os.Exit(runImportTopics(os.Args[2:]))
+	}
+
 	cfg := config.Load()
 	startedAt := time.Now().UTC()
 	logWriter, closeLogs := setupLogging(cfg)
@@ -78,11 +88,32 @@ func main() {
 	lyricsAction := actions.NewLyricsAction(lyricsRepository)
 	coverRepository := infrastructure.NewCoverRepo(db)
 	coverAction := actions.NewCoverAction(coverRepository)
+	liveHistoryRepository := infrastructure.NewLiveHistoryRepo(db)
+	liveHistoryAction := actions.NewLiveHistoryAction(liveHistoryRepository)
+	if err := liveHistoryAction.CloseOpenEntries(context.Background()); err != nil {
+		log.Errorf("cannot close open live history entries: %v", err)
+	}
+	collectionRepository := infrastructure.NewCollectionRepo(db)
+	collectionAction := actions.NewCollectionAction(collectionRepository, bibleRepository)
+	planRepository := infrastructure.NewPlanRepo(db)
+	planAction := actions.NewPlanAction(planRepository, bibleRepository)
+	topicRepository := infrastructure.NewTopicRepo(db)
+	topicAction := actions.NewTopicAction(topicRepository, bibleRepository)
+	bookAliasAction := actions.NewBookAliasAction(infrastructure.NewBookAliasRepo(db))
+	if err := bookAliasAction.LoadBookAliases(context.Background()); err != nil {
+		log.Errorf("cannot load book aliases: %v", err)
+	}
 	videoUploadPath := filepath.Join(cfg.UploadDir, "videos")
 	imageUploadPath := filepath.Join(cfg.UploadDir, "images")
 	bibleHandler := handlers.NewBibleHandler(bibleAction, videoUploadPath, imageUploadPath, apiPrefix)
 	lyricsHandler := handlers.NewLyricsHandler(lyricsAction)
 	coverHandler := handlers.NewCoverHandler(coverAction)
+	liveHistoryHandler := handlers.NewLiveHistoryHandler(liveHistoryAction)
+	collectionHandler := handlers.NewCollectionHandler(collectionAction)
+	planHandler := handlers.NewPlanHandler(planAction)
+	topicHandler := handlers.NewTopicHandler(topicAction)
+	bookAliasHandler := handlers.NewBookAliasHandler(bookAliasAction)
+	webSocketHandler := handlers.NewWebSocketHandler(liveHistoryAction)
 
 	logDatabaseConfig(cfg)
 	logDatabaseSummary(db)
@@ -95,8 +126,18 @@ func main() {
 	lyricsHandler.RegisterRoutes(apiRouter, nil)
 	coverHandler.RegisterRoutes(router, nil)
 	coverHandler.RegisterRoutes(apiRouter, nil)
-
-	server.GET("/ws", handlers.HandleWebSocket)
+	liveHistoryHandler.RegisterRoutes(router, nil)
+	liveHistoryHandler.RegisterRoutes(apiRouter, nil)
+	collectionHandler.RegisterRoutes(router, nil)
+	collectionHandler.RegisterRoutes(apiRouter, nil)
+	planHandler.RegisterRoutes(router, nil)
+	planHandler.RegisterRoutes(apiRouter, nil)
+	topicHandler.RegisterRoutes(router, nil)
+	topicHandler.RegisterRoutes(apiRouter, nil)
+	bookAliasHandler.RegisterRoutes(router, nil)
+	bookAliasHandler.RegisterRoutes(apiRouter, nil)
+
+	server.GET("/ws", webSocketHandler.HandleWebSocket)
 
 	registerVideoRoutes(router, videoUploadPath)
 	registerVideoRoutes(apiRouter, videoUploadPath)
@@ -68,3 +68,229 @@ var Books = map[string]string{
 	"judas":            "Judas",
 	"apocalipsis":      "Apocalipsis",
 }
+
+// OSISBooks maps OSIS book identifiers onto the slugs used in Books.
+var OSISBooks = map[string]string{
+	"Gen":    "genesis",
+	"Exod":   "exodo",
+	"Lev":    "levitico",
+	"Num":    "numeros",
+	"Deut":   "deuteronomio",
+	"Josh":   "josue",
+	"Judg":   "jueces",
+	"Ruth":   "rut",
+	"1Sam":   "1-samuel",
+	"2Sam":   "2-samuel",
+	"1Kgs":   "1-reyes",
+	"2Kgs":   "2-reyes",
+	"1Chr":   "1-cronicas",
+	"2Chr":   "2-cronicas",
+	"Ezra":   "esdras",
+	"Neh":    "nehemias",
+	"Esth":   "ester",
+	"Job":    "job",
+	"Ps":     "salmos",
+	"Prov":   "proverbios",
+	"Eccl":   "eclesiastes",
+	"Song":   "cantares",
+	"Isa":    "isaias",
+	"Jer":    "jeremias",
+	"Lam":    "lamentaciones",
+	"Ezek":   "ezequiel",
+	"Dan":    "daniel",
+	"Hos":    "oseas",
+	"Joel":   "joel",
+	"Amos":   "amos",
+	"Obad":   "abdias",
+	"Jonah":  "jonas",
+	"Mic":    "miqueas",
+	"Nah":    "nahum",
+	"Hab":    "habacuc",
+	"Zeph":   "sofonias",
+	"Hag":    "hageo",
+	"Zech":   "zacarias",
+	"Mal":    "malaquias",
+	"Matt":   "mateo",
+	"Mark":   "marcos",
+	"Luke":   "lucas",
+	"John":   "juan",
+	"Acts":   "hechos",
+	"Rom":    "romanos",
+	"1Cor":   "1-corintios",
+	"2Cor":   "2-corintios",
+	"Gal":    "galatas",
+	"Eph":    "efesios",
+	"Phil":   "filipenses",
+	"Col":    "colosenses",
+	"1Thess": "1-tesalonicenses",
+	"2Thess": "2-tesalonicenses",
+	"1Tim":   "1-timoteo",
+	"2Tim":   "2-timoteo",
+	"Titus":  "tito",
+	"Phlm":   "filemon",
+	"Heb":    "hebreos",
+	"Jas":    "santiago",
+	"1Pet":   "1-pedro",
+	"2Pet":   "2-pedro",
+	"1John":  "1-juan",
+	"2John":  "2-juan",
+	"3John":  "3-juan",
+	"Jude":   "judas",
+	"Rev":    "apocalipsis",
+}
+
+// BookOrder lists the slugs of Books in canonical order.
+var BookOrder = []string{
+	"genesis", "exodo", "levitico", "numeros", "deuteronomio", "josue", "jueces", "rut",
+	"1-samuel", "2-samuel", "1-reyes", "2-reyes", "1-cronicas", "2-cronicas", "esdras",
+	"nehemias", "ester", "job", "salmos", "proverbios", "eclesiastes", "cantares", "isaias",
+	"jeremias", "lamentaciones", "ezequiel", "daniel", "oseas", "joel", "amos", "abdias",
+	"jonas", "miqueas", "nahum", "habacuc", "sofonias", "hageo", "zacarias", "malaquias",
+	"mateo", "marcos", "lucas", "juan", "hechos", "romanos", "1-corintios", "2-corintios",
+	"galatas", "efesios", "filipenses", "colosenses", "1-tesalonicenses", "2-tesalonicenses",
+	"1-timoteo", "2-timoteo", "tito", "filemon", "hebreos", "santiago", "1-pedro", "2-pedro",
+	"1-juan", "2-juan", "3-juan", "judas", "apocalipsis",
+}
+
+// USFMBooks maps USFM/USX book codes onto the slugs used in Books.
+var USFMBooks = map[string]string{
+	"GEN": "genesis",
+	"EXO": "exodo",
+	"LEV": "levitico",
+	"NUM": "numeros",
+	"DEU": "deuteronomio",
+	"JOS": "josue",
+	"JDG": "jueces",
+	"RUT": "rut",
+	"1SA": "1-samuel",
+	"2SA": "2-samuel",
+	"1KI": "1-reyes",
+	"2KI": "2-reyes",
+	"1CH": "1-cronicas",
+	"2CH": "2-cronicas",
+	"EZR": "esdras",
+	"NEH": "nehemias",
+	"EST": "ester",
+	"JOB": "job",
+	"PSA": "salmos",
+	"PRO": "proverbios",
+	"ECC": "eclesiastes",
+	"SNG": "cantares",
+	"ISA": "isaias",
+	"JER": "jeremias",
+	"LAM": "lamentaciones",
+	"EZK": "ezequiel",
+	"DAN": "daniel",
+	"HOS": "oseas",
+	"JOL": "joel",
+	"AMO": "amos",
+	"OBA": "abdias",
+	"JON": "jonas",
+	"MIC": "miqueas",
+	"NAM": "nahum",
+	"HAB": "habacuc",
+	"ZEP": "sofonias",
+	"HAG": "hageo",
+	"ZEC": "zacarias",
+	"MAL": "malaquias",
+	"MAT": "mateo",
+	"MRK": "marcos",
+	"LUK": "lucas",
+	"JHN": "juan",
+	"ACT": "hechos",
+	"ROM": "romanos",
+	"1CO": "1-corintios",
+	"2CO": "2-corintios",
+	"GAL": "galatas",
+	"EPH": "efesios",
+	"PHP": "filipenses",
+	"COL": "colosenses",
+	"1TH": "1-tesalonicenses",
+	"2TH": "2-tesalonicenses",
+	"1TI": "1-timoteo",
+	"2TI": "2-timoteo",
+	"TIT": "tito",
+	"PHM": "filemon",
+	"HEB": "hebreos",
+	"JAS": "santiago",
+	"1PE": "1-pedro",
+	"2PE": "2-pedro",
+	"1JN": "1-juan",
+	"2JN": "2-juan",
+	"3JN": "3-juan",
+	"JUD": "judas",
+	"REV": "apocalipsis",
+}
+
+// BookAliases lists English names and common Spanish and English
+// abbreviations for each slug in Books, in addition to the slug and display
+// name themselves. Ordinal prefixes are written as digits ("1 Co"); the
+// reference parser also accepts "I", "1ra", "Primera" or "First" in their place.
+var BookAliases = map[string][]string{
+	"genesis":          {"Genesis", "Gn", "Gen", "Ge"},
+	"exodo":            {"Exodus", "Ex", "Exo", "Exod"},
+	"levitico":         {"Leviticus", "Lv", "Lev"},
+	"numeros":          {"Numbers", "Nm", "Num", "Nu"},
+	"deuteronomio":     {"Deuteronomy", "Dt", "Deut", "Dtn"},
+	"josue":            {"Joshua", "Jos", "Josh"},
+	"jueces":           {"Judges", "Jue", "Jc", "Judg", "Jdg"},
+	"rut":              {"Ruth", "Rt", "Ru"},
+	"1-samuel":         {"1 Samuel", "1 S", "1 Sa", "1 Sam", "1 Sm"},
+	"2-samuel":         {"2 Samuel", "2 S", "2 Sa", "2 Sam", "2 Sm"},
+	"1-reyes":          {"1 Kings", "1 R", "1 Re", "1 Rey", "1 Kgs", "1 Ki"},
+	"2-reyes":          {"2 Kings", "2 R", "2 Re", "2 Rey", "2 Kgs", "2 Ki"},
+	"1-cronicas":       {"1 Chronicles", "1 Cr", "1 Cro", "1 Cron", "1 Chr", "1 Chron"},
+	"2-cronicas":       {"2 Chronicles", "2 Cr", "2 Cro", "2 Cron", "2 Chr", "2 Chron"},
+	"esdras":           {"Ezra", "Esd", "Ezr"},
+	"nehemias":         {"Nehemiah", "Ne", "Neh"},
+	"ester":            {"Esther", "Est", "Esth"},
+	"job":              {"Job", "Jb"},
+	"salmos":           {"Psalms", "Psalm", "Salmo", "Sal", "Sl", "Ps", "Psa", "Pss"},
+	"proverbios":       {"Proverbs", "Pr", "Pro", "Prov", "Prv"},
+	"eclesiastes":      {"Ecclesiastes", "Qohelet", "Ec", "Ecl", "Ecc", "Eccl", "Qo"},
+	"cantares":         {"Cantar de los Cantares", "Song of Songs", "Song of Solomon", "Cnt", "Ct", "Cant", "Song", "SS"},
+	"isaias":           {"Isaiah", "Is", "Isa"},
+	"jeremias":         {"Jeremiah", "Jr", "Jer"},
+	"lamentaciones":    {"Lamentations", "Lm", "Lam"},
+	"ezequiel":         {"Ezekiel", "Ez", "Eze", "Ezek"},
+	"daniel":           {"Daniel", "Dn", "Dan"},
+	"oseas":            {"Hosea", "Os", "Hos"},
+	"joel":             {"Joel", "Jl"},
+	"amos":             {"Amos", "Am"},
+	"abdias":           {"Obadiah", "Abd", "Ab", "Obad", "Ob"},
+	"jonas":            {"Jonah", "Jon", "Jnh"},
+	"miqueas":          {"Micah", "Mi", "Miq", "Mic"},
+	"nahum":            {"Nahum", "Na", "Nah"},
+	"habacuc":          {"Habakkuk", "Ha", "Hab"},
+	"sofonias":         {"Zephaniah", "So", "Sof", "Zep", "Zeph"},
+	"hageo":            {"Haggai", "Ag", "Hag", "Hg"},
+	"zacarias":         {"Zechariah", "Za", "Zac", "Zec", "Zech"},
+	"malaquias":        {"Malachi", "Ml", "Mal"},
+	"mateo":            {"Matthew", "Mt", "Mat", "Matt"},
+	"marcos":           {"Mark", "Mc", "Mr", "Mar", "Mk", "Mrk"},
+	"lucas":            {"Luke", "Lc", "Luc", "Lk", "Lu"},
+	"juan":             {"John", "Jn", "Jua", "Joh", "Jhn"},
+	"hechos":           {"Acts", "Hch", "Hech", "Hec", "Act"},
+	"romanos":          {"Romans", "Ro", "Rom", "Rm"},
+	"1-corintios":      {"1 Corinthians", "1 Co", "1 Cor"},
+	"2-corintios":      {"2 Corinthians", "2 Co", "2 Cor"},
+	"galatas":          {"Galatians", "Ga", "Gal", "Gl"},
+	"efesios":          {"Ephesians", "Ef", "Efe", "Eph"},
+	"filipenses":       {"Philippians", "Flp", "Fil", "Php", "Phil"},
+	"colosenses":       {"Colossians", "Col", "Cl"},
+	"1-tesalonicenses": {"1 Thessalonians", "1 Ts", "1 Tes", "1 Th", "1 Thess"},
+	"2-tesalonicenses": {"2 Thessalonians", "2 Ts", "2 Tes", "2 Th", "2 Thess"},
+	"1-timoteo":        {"1 Timothy", "1 Ti", "1 Tim", "1 Tm"},
+	"2-timoteo":        {"2 Timothy", "2 Ti", "2 Tim", "2 Tm"},
+	"tito":             {"Titus", "Tit", "Tt"},
+	"filemon":          {"Philemon", "Flm", "Film", "Phlm", "Phm"},
+	"hebreos":          {"Hebrews", "He", "Heb", "Hb"},
+	"santiago":         {"James", "Stg", "Sant", "Jas", "Jm"},
+	"1-pedro":          {"1 Peter", "1 P", "1 Pe", "1 Ped", "1 Pet", "1 Pt"},
+	"2-pedro":          {"2 Peter", "2 P", "2 Pe", "2 Ped", "2 Pet", "2 Pt"},
+	"1-juan":           {"1 John", "1 Jn", "1 Jua", "1 Jhn"},
+	"2-juan":           {"2 John", "2 Jn", "2 Jua", "2 Jhn"},
+	"3-juan":           {"3 John", "3 Jn", "3 Jua", "3 Jhn"},
+	"judas":            {"Jude", "Jud", "Jds", "Jd"},
+	"apocalipsis":      {"Revelation", "Revelations", "Ap", "Apoc", "Rev", "Rv"},
+}
@@ -0,0 +1,166 @@
+package consts
+
+// Testaments as returned in the book catalog, the same keys the search
+// filters at: and nt: use.
+const (
+	OldTestament = "at"
+	NewTestament = "nt"
+)
+
+// OldTestamentBooks is the number of books before Mateo in BookOrder.
+const OldTestamentBooks = 39
+
+// BookAbbreviations holds the usual Spanish abbreviation of each slug in
+// Books, as printed in Reina Valera editions.
+var BookAbbreviations = map[string]string{
+	"genesis":          "Gn",
+	"exodo":            "Éx",
+	"levitico":         "Lv",
+	"numeros":          "Nm",
+	"deuteronomio":     "Dt",
+	"josue":            "Jos",
+	"jueces":           "Jue",
+	"rut":              "Rt",
+	"1-samuel":         "1 S",
+	"2-samuel":         "2 S",
+	"1-reyes":          "1 R",
+	"2-reyes":          "2 R",
+	"1-cronicas":       "1 Cr",
+	"2-cronicas":       "2 Cr",
+	"esdras":           "Esd",
+	"nehemias":         "Neh",
+	"ester":            "Est",
+	"job":              "Job",
+	"salmos":           "Sal",
+	"proverbios":       "Pr",
+	"eclesiastes":      "Ec",
+	"cantares":         "Cnt",
+	"isaias":           "Is",
+	"jeremias":         "Jer",
+	"lamentaciones":    "Lm",
+	"ezequiel":         "Ez",
+	"daniel":           "Dn",
+	"oseas":            "Os",
+	"joel":             "Jl",
+	"amos":             "Am",
+	"abdias":           "Abd",
+	"jonas":            "Jon",
+	"miqueas":          "Mi",
+	"nahum":            "Nah",
+	"habacuc":          "Hab",
+	"sofonias":         "Sof",
+	"hageo":            "Hag",
+	"zacarias":         "Zac",
+	"malaquias":        "Mal",
+	"mateo":            "Mt",
+	"marcos":           "Mr",
+	"lucas":            "Lc",
+	"juan":             "Jn",
+	"hechos":           "Hch",
+	"romanos":          "Ro",
+	"1-corintios":      "1 Co",
+	"2-corintios":      "2 Co",
+	"galatas":          "Gá",
+	"efesios":          "Ef",
+	"filipenses":       "Fil",
+	"colosenses":       "Col",
+	"1-tesalonicenses": "1 Ts",
+	"2-tesalonicenses": "2 Ts",
+	"1-timoteo":        "1 Ti",
+	"2-timoteo":        "2 Ti",
+	"tito":             "Tit",
+	"filemon":          "Flm",
+	"hebreos":          "He",
+	"santiago":         "Stg",
+	"1-pedro":          "1 P",
+	"2-pedro":          "2 P",
+	"1-juan":           "1 Jn",
+	"2-juan":           "2 Jn",
+	"3-juan":           "3 Jn",
+	"judas":            "Jud",
+	"apocalipsis":      "Ap",
+}
+
+// Genres names the groups books are listed under.
+var Genres = map[string]string{
+	"pentateuco":          "Pentateuco",
+	"historicos":          "Históricos",
+	"poeticos":            "Poéticos",
+	"profetas-mayores":    "Profetas mayores",
+	"profetas-menores":    "Profetas menores",
+	"evangelios":          "Evangelios",
+	"epistolas-paulinas":  "Epístolas paulinas",
+	"epistolas-generales": "Epístolas generales",
+	"profecia":            "Profecía",
+}
+
+// BookGenres maps each slug in Books onto its group in Genres. Hechos is
+// listed with the historical books.
+var BookGenres = map[string]string{
+	"genesis":          "pentateuco",
+	"exodo":            "pentateuco",
+	"levitico":         "pentateuco",
+	"numeros":          "pentateuco",
+	"deuteronomio":     "pentateuco",
+	"josue":            "historicos",
+	"jueces":           "historicos",
+	"rut":              "historicos",
+	"1-samuel":         "historicos",
+	"2-samuel":         "historicos",
+	"1-reyes":          "historicos",
+	"2-reyes":          "historicos",
+	"1-cronicas":       "historicos",
+	"2-cronicas":       "historicos",
+	"esdras":           "historicos",
+	"nehemias":         "historicos",
+	"ester":            "historicos",
+	"job":              "poeticos",
+	"salmos":           "poeticos",
+	"proverbios":       "poeticos",
+	"eclesiastes":      "poeticos",
+	"cantares":         "poeticos",
+	"isaias":           "profetas-mayores",
+	"jeremias":         "profetas-mayores",
+	"lamentaciones":    "profetas-mayores",
+	"ezequiel":         "profetas-mayores",
+	"daniel":           "profetas-mayores",
+	"oseas":            "profetas-menores",
+	"joel":             "profetas-menores",
+	"amos":             "profetas-menores",
+	"abdias":           "profetas-menores",
+	"jonas":            "profetas-menores",
+	"miqueas":          "profetas-menores",
+	"nahum":            "profetas-menores",
+	"habacuc":          "profetas-menores",
+	"sofonias":         "profetas-menores",
+	"hageo":            "profetas-menores",
+	"zacarias":         "profetas-menores",
+	"malaquias":        "profetas-menores",
+	"mateo":            "evangelios",
+	"marcos":           "evangelios",
+	"lucas":            "evangelios",
+	"juan":             "evangelios",
+	"hechos":           "historicos",
+	"romanos":          "epistolas-paulinas",
+	"1-corintios":      "epistolas-paulinas",
+	"2-corintios":      "epistolas-paulinas",
+	"galatas":          "epistolas-paulinas",
+	"efesios":          "epistolas-paulinas",
+	"filipenses":       "epistolas-paulinas",
+	"colosenses":       "epistolas-paulinas",
+	"1-tesalonicenses": "epistolas-paulinas",
+	"2-tesalonicenses": "epistolas-paulinas",
+	"1-timoteo":        "epistolas-paulinas",
+	"2-timoteo":        "epistolas-paulinas",
+	"tito":             "epistolas-paulinas",
+	"filemon":          "epistolas-paulinas",
+	"hebreos":          "epistolas-generales",
+	"santiago":         "epistolas-generales",
+	"1-pedro":          "epistolas-generales",
+	"2-pedro":          "epistolas-generales",
+	"1-juan":           "epistolas-generales",
+	"2-juan":           "epistolas-generales",
+	"3-juan":           "epistolas-generales",
+	"judas":            "epistolas-generales",
+	"apocalipsis":      "profecia",
+}
@@ -0,0 +1,116 @@
+package consts
+
+// DailyVerses is the curated list the verse of the day rotates through, one
+// entry per day. References use the standard numbering and stay inside one
+// chapter.
+var DailyVerses = []string{
+	"Génesis 1:1",
+	"Josué 1:9",
+	"Números 6:24-26",
+	"Deuteronomio 31:6",
+	"1 Samuel 16:7",
+	"2 Crónicas 7:14",
+	"Nehemías 8:10",
+	"Job 19:25",
+	"Salmos 1:1-2",
+	"Salmos 16:11",
+	"Salmos 18:2",
+	"Salmos 19:14",
+	"Salmos 23:1",
+	"Salmos 27:1",
+	"Salmos 34:8",
+	"Salmos 37:4",
+	"Salmos 37:5",
+	"Salmos 46:1",
+	"Salmos 46:10",
+	"Salmos 51:10",
+	"Salmos 55:22",
+	"Salmos 62:1-2",
+	"Salmos 84:11",
+	"Salmos 90:12",
+	"Salmos 91:1-2",
+	"Salmos 100:4-5",
+	"Salmos 103:2-3",
+	"Salmos 118:24",
+	"Salmos 119:105",
+	"Salmos 121:1-2",
+	"Salmos 127:1",
+	"Salmos 139:14",
+	"Salmos 145:18",
+	"Salmos 147:3",
+	"Proverbios 3:5-6",
+	"Proverbios 4:23",
+	"Proverbios 16:3",
+	"Proverbios 18:10",
+	"Eclesiastés 3:1",
+	"Isaías 9:6",
+	"Isaías 26:3",
+	"Isaías 40:31",
+	"Isaías 41:10",
+	"Isaías 43:2",
+	"Isaías 53:5",
+	"Isaías 55:8-9",
+	"Jeremías 17:7",
+	"Jeremías 29:11",
+	"Jeremías 33:3",
+	"Lamentaciones 3:22-23",
+	"Miqueas 6:8",
+	"Habacuc 3:19",
+	"Sofonías 3:17",
+	"Mateo 5:14",
+	"Mateo 6:33",
+	"Mateo 7:7",
+	"Mateo 11:28",
+	"Mateo 28:19-20",
+	"Marcos 10:27",
+	"Marcos 12:30",
+	"Lucas 1:37",
+	"Lucas 6:31",
+	"Juan 1:12",
+	"Juan 3:16",
+	"Juan 8:12",
+	"Juan 10:10",
+	"Juan 11:25",
+	"Juan 14:6",
+	"Juan 14:27",
+	"Juan 15:5",
+	"Juan 16:33",
+	"Hechos 1:8",
+	"Romanos 5:8",
+	"Romanos 8:1",
+	"Romanos 8:28",
+	"Romanos 8:38-39",
+	"Romanos 10:9",
+	"Romanos 12:2",
+	"Romanos 15:13",
+	"1 Corintios 10:13",
+	"1 Corintios 13:4-7",
+	"1 Corintios 16:14",
+	"2 Corintios 5:17",
+	"2 Corintios 12:9",
+	"Gálatas 2:20",
+	"Gálatas 5:22-23",
+	"Gálatas 6:9",
+	"Efesios 2:8-9",
+	"Efesios 3:20",
+	"Efesios 4:32",
+	"Filipenses 1:6",
+	"Filipenses 4:6-7",
+	"Filipenses 4:13",
+	"Filipenses 4:19",
+	"Colosenses 3:23",
+	"1 Tesalonicenses 5:16-18",
+	"2 Timoteo 1:7",
+	"2 Timoteo 3:16",
+	"Hebreos 4:16",
+	"Hebreos 11:1",
+	"Hebreos 12:1-2",
+	"Hebreos 13:8",
+	"Santiago 1:5",
+	"Santiago 4:8",
+	"1 Pedro 5:7",
+	"1 Juan 1:9",
+	"1 Juan 4:19",
+	"Apocalipsis 3:20",
+	"Apocalipsis 21:4",
+}
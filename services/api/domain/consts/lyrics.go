@@ -0,0 +1,20 @@
+package consts
+
+// Segment kinds offered by LyricsStudio.
+const (
+	SegmentVerse  = "Verso"
+	SegmentChorus = "Coro"
+	SegmentBridge = "Puente"
+	SegmentIntro  = "Intro"
+	SegmentOutro  = "Outro"
+)
+
+// SegmentColors gives every kind the same palette entry of LyricsStudio,
+// so segments created by the server look alike whatever their source.
+var SegmentColors = map[string]string{
+	SegmentVerse:  "bg-emerald-100 text-emerald-700 border-emerald-200",
+	SegmentChorus: "bg-blue-100 text-blue-700 border-blue-200",
+	SegmentBridge: "bg-rose-100 text-rose-700 border-rose-200",
+	SegmentIntro:  "bg-amber-100 text-amber-700 border-amber-200",
+	SegmentOutro:  "bg-purple-100 text-purple-700 border-purple-200",
+}
@@ -0,0 +1,6 @@
+package consts
+
+// StandardVersification is the chapter and verse numbering of the bundled
+// Reina Valera 1960, shared by most Protestant Spanish and English bibles.
+// Other schemes are described by rows in versification_mappings.
+const StandardVersification = "standard"
@@ -5,5 +5,43 @@ type RequestBible struct {
 	Chapter int    `json:"chapter" validate:"required"`
 	Offset  int    `json:"offset"`
 	Limit   int    `json:"limit"`
-	Version int    `json:"version" validate:"required"`
+	Version int    `json:"version"`
+}
+
+// Bible is an installed version. License is the full copyright notice;
+// Attribution is the short line projected next to the verses of a licensed
+// translation.
+type Bible struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Abbreviation  string `json:"abbreviation"`
+	Language      string `json:"language"`
+	License       string `json:"license"`
+	Attribution   string `json:"attribution"`
+	Versification string `json:"versification"`
+	Books         int    `json:"books"`
+	Verses        int    `json:"verses"`
+}
+
+// Translation identifies the version a text was read from. Attribution is
+// what goes on screen: the version's attribution, or its abbreviation when
+// it has none.
+type Translation struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+	Language     string `json:"language"`
+	License      string `json:"license,omitempty"`
+	Attribution  string `json:"attribution"`
+}
+
+// BibleMetadata updates the catalog details of an installed version. A
+// field left out of the request keeps its stored value.
+type BibleMetadata struct {
+	ID           int     `json:"id" validate:"required"`
+	Name         *string `json:"name"`
+	Abbreviation *string `json:"abbreviation"`
+	Language     *string `json:"language"`
+	License      *string `json:"license"`
+	Attribution  *string `json:"attribution"`
 }
@@ -0,0 +1,59 @@
+package entities
+
+type BibleImport struct {
+	Name          string       `json:"name"`
+	Abbreviation  string       `json:"abbreviation"`
+	Language      string       `json:"language"`
+	License       string       `json:"license,omitempty"`
+	Attribution   string       `json:"attribution,omitempty"`
+	Versification string       `json:"versification,omitempty"`
+	Books         []ImportBook `json:"books"`
+}
+
+type ImportBook struct {
+	Name     string          `json:"name"`
+	Title    string          `json:"title"`
+	Chapters []ImportChapter `json:"chapters"`
+}
+
+type ImportChapter struct {
+	Index    int           `json:"index"`
+	Research string        `json:"research,omitempty"`
+	Verses   []ImportVerse `json:"verses"`
+}
+
+type ImportVerse struct {
+	Index    int    `json:"index"`
+	Last     int    `json:"last,omitempty"`
+	Research string `json:"research,omitempty"`
+	Text     string `json:"text"`
+}
+
+// BibleImportOptions tunes an import. ValidateOnly reports what the file
+// lacks without storing it; Force stores it even when it lacks something.
+type BibleImportOptions struct {
+	ValidateOnly bool `json:"validateOnly"`
+	Force        bool `json:"force"`
+}
+
+type ImportValidation struct {
+	MissingBooks    []string         `json:"missingBooks"`
+	MissingChapters []MissingChapter `json:"missingChapters"`
+	MissingVerses   []MissingVerses  `json:"missingVerses"`
+}
+
+type MissingChapter struct {
+	Book    string `json:"book"`
+	Chapter int    `json:"chapter"`
+}
+
+type MissingVerses struct {
+	Book    string `json:"book"`
+	Chapter int    `json:"chapter"`
+	Verses  []int  `json:"verses"`
+}
+
+type BibleImportResult struct {
+	Bible      *Bible           `json:"bible,omitempty"`
+	Validation ImportValidation `json:"validation"`
+}
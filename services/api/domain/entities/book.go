@@ -0,0 +1,22 @@
+package entities
+
+type RequestBooks struct {
+	Version int `json:"version"`
+}
+
+// BookInfo describes a book of one bible version for book pickers. Verses
+// holds the verse count of each chapter, chapter 1 first.
+type BookInfo struct {
+	Book         string   `json:"book"`
+	Name         string   `json:"name"`
+	Position     int      `json:"position"`
+	Abbreviation string   `json:"abbreviation"`
+	OSIS         string   `json:"osis"`
+	USFM         string   `json:"usfm"`
+	Aliases      []string `json:"aliases"`
+	Testament    string   `json:"testament"`
+	Genre        string   `json:"genre"`
+	GenreName    string   `json:"genreName"`
+	Chapters     int      `json:"chapters"`
+	Verses       []int    `json:"verses"`
+}
@@ -0,0 +1,22 @@
+package entities
+
+// BookAlias is another name for a book, like "Gênesis" or "Gen" for
+// "genesis". Seeded aliases have a Language (es, en or pt); the ones a
+// church adds are Custom and can be removed.
+type BookAlias struct {
+	ID       int64  `json:"id"`
+	Book     string `json:"book"`
+	Alias    string `json:"alias"`
+	Language string `json:"language"`
+	Custom   bool   `json:"custom"`
+}
+
+type RequestBookAliases struct {
+	Book string `json:"book"`
+}
+
+type BookAliasPayload struct {
+	Book     string `json:"book" validate:"required"`
+	Alias    string `json:"alias" validate:"required"`
+	Language string `json:"language"`
+}
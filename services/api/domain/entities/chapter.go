@@ -3,11 +3,13 @@ package entities
 type Verse struct {
 	Research string `json:"research,omitempty"`
 	Index    int    `json:"index"`
+	Last     int    `json:"last,omitempty"`
 	Text     string `json:"text"`
 }
 
 type Chapter struct {
-	Name     string  `json:"name"`
-	Research string  `json:"research,omitempty"`
-	Verses   []Verse `json:"verses"`
+	Name        string       `json:"name"`
+	Research    string       `json:"research,omitempty"`
+	Verses      []Verse      `json:"verses"`
+	Translation *Translation `json:"translation,omitempty"`
 }
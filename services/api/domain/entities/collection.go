@@ -0,0 +1,56 @@
+package entities
+
+// VerseCollection is a named list of references kept for later, like the
+// scriptures of a sermon. Items are in display order.
+type VerseCollection struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Items       []CollectionItem `json:"items"`
+	CreatedAt   string           `json:"createdAt"`
+	UpdatedAt   string           `json:"updatedAt"`
+}
+
+// CollectionItem is one reference of a collection, stored in its canonical
+// display form, e.g. "Romanos 8:28-30".
+type CollectionItem struct {
+	Reference string `json:"reference"`
+	Note      string `json:"note"`
+}
+
+type VerseCollectionSummary struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Items     int    `json:"items"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type VerseCollectionPayload struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Items       []CollectionItem `json:"items"`
+}
+
+type RequestCollectionText struct {
+	ID      string `json:"id" validate:"required"`
+	Version int    `json:"version"`
+}
+
+// CollectionText is a collection with the text of every item in one
+// version. An item the version cannot show, like a verse its numbering
+// lacks, keeps its reference and note and carries Error instead of Passage.
+type CollectionText struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Version     int                 `json:"version"`
+	Items       []CollectionPassage `json:"items"`
+}
+
+type CollectionPassage struct {
+	Reference string   `json:"reference"`
+	Note      string   `json:"note"`
+	Passage   *Passage `json:"passage,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
@@ -0,0 +1,40 @@
+package entities
+
+type RequestRelated struct {
+	Book    string `json:"book" validate:"required"`
+	Chapter int    `json:"chapter" validate:"required"`
+	Verse   int    `json:"verse" validate:"required"`
+	Version int    `json:"version"`
+	Limit   int    `json:"limit"`
+}
+
+// CrossReference links a verse to a related range, both in the standard
+// numbering. Votes ranks the links of one verse, highest first.
+type CrossReference struct {
+	Book    string         `json:"book"`
+	Chapter int            `json:"chapter"`
+	Verse   int            `json:"verse"`
+	Target  ReferenceRange `json:"target"`
+	Votes   int            `json:"votes"`
+}
+
+// CrossReferenceImport reports how many lines of a cross-reference file
+// were stored and how many were left out.
+type CrossReferenceImport struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+// RelatedVerses lists the passages linked to one verse, best ranked first.
+// References follow the numbering of the requested version.
+type RelatedVerses struct {
+	Reference string           `json:"reference"`
+	Related   []RelatedPassage `json:"related"`
+}
+
+type RelatedPassage struct {
+	Reference string         `json:"reference"`
+	Range     ReferenceRange `json:"range"`
+	Votes     int            `json:"votes"`
+	Verses    []Verse        `json:"verses"`
+}
@@ -0,0 +1,29 @@
+package entities
+
+// LiveHistoryEntry is one scene for as long as it stayed live. Consecutive
+// slides of the same song or reference share an entry and are counted in
+// Slides. Times are RFC 3339 in UTC; EndedAt is empty while it is showing.
+type LiveHistoryEntry struct {
+	ID        int64  `json:"id"`
+	StartedAt string `json:"startedAt"`
+	EndedAt   string `json:"endedAt"`
+	Duration  int    `json:"durationSeconds"`
+	Type      string `json:"type"`
+	Reference string `json:"reference"`
+	SongID    string `json:"songId"`
+	Title     string `json:"title"`
+	Slides    int    `json:"slides"`
+	Source    string `json:"source"`
+}
+
+// LiveHistoryDay summarizes one local calendar day of the history.
+type LiveHistoryDay struct {
+	Date     string `json:"date"`
+	Entries  int    `json:"entries"`
+	Duration int    `json:"durationSeconds"`
+}
+
+type RequestLiveHistory struct {
+	Date   string `json:"date"`
+	Format string `json:"format"`
+}
@@ -1,13 +1,26 @@
 package entities
 
+// LyricsSegment is one slide of a song. Content is the text projected;
+// Chords, when the song has them, is the same text in ChordPro notation
+// ("[G]Sublime [C]gracia").
 type LyricsSegment struct {
 	ID      string `json:"id"`
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	Chords  string `json:"chords,omitempty"`
 	Kind    string `json:"kind"`
 	Color   string `json:"color"`
 }
 
+// LyricsArrangement is a named order in which the segments of a song are
+// sung, such as "v1 c v2 c b c c". Sequence holds segment ids and may
+// repeat them.
+type LyricsArrangement struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Sequence []string `json:"sequence"`
+}
+
 type LyricsSettings struct {
 	FontFamily     string `json:"fontFamily"`
 	FontSize       int    `json:"fontSize"`
@@ -17,26 +30,151 @@ type LyricsSettings struct {
 	HighlightColor string `json:"highlightColor"`
 }
 
+// LyricsSong is a song of the library. Key is its original key ("G",
+// "F#m") and Tempo its beats per minute, 0 when unknown. Segments are
+// sung in the order of DefaultArrangement, or as listed when the song has
+// no arrangements. Credits is the authors, copyright and CCLI line shown
+// on the last slide.
 type LyricsSong struct {
-	ID        string          `json:"id"`
-	Title     string          `json:"title"`
-	Lyrics    string          `json:"lyrics"`
-	Segments  []LyricsSegment `json:"segments"`
-	Settings  LyricsSettings  `json:"settings"`
-	CreatedAt string          `json:"createdAt"`
-	UpdatedAt string          `json:"updatedAt"`
+	ID                 string              `json:"id"`
+	Title              string              `json:"title"`
+	Lyrics             string              `json:"lyrics"`
+	Segments           []LyricsSegment     `json:"segments"`
+	Arrangements       []LyricsArrangement `json:"arrangements"`
+	DefaultArrangement string              `json:"defaultArrangement"`
+	Settings           LyricsSettings      `json:"settings"`
+	Authors            []string            `json:"authors"`
+	Copyright          string              `json:"copyright"`
+	CCLI               string              `json:"ccli"`
+	Key                string              `json:"key"`
+	Tempo              int                 `json:"tempo"`
+	Tags               []string            `json:"tags"`
+	Credits            string              `json:"credits,omitempty"`
+	CreatedAt          string              `json:"createdAt"`
+	UpdatedAt          string              `json:"updatedAt"`
 }
 
 type LyricsSongSummary struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	UpdatedAt string `json:"updatedAt"`
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Authors   []string `json:"authors,omitempty"`
+	CCLI      string   `json:"ccli,omitempty"`
+	Key       string   `json:"key,omitempty"`
+	Tempo     int      `json:"tempo,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	UpdatedAt string   `json:"updatedAt"`
 }
 
 type LyricsSongPayload struct {
-	ID       string          `json:"id"`
-	Title    string          `json:"title"`
-	Lyrics   string          `json:"lyrics"`
+	ID                 string              `json:"id"`
+	Title              string              `json:"title"`
+	Lyrics             string              `json:"lyrics"`
+	Segments           []LyricsSegment     `json:"segments"`
+	Arrangements       []LyricsArrangement `json:"arrangements"`
+	DefaultArrangement string              `json:"defaultArrangement"`
+	Settings           LyricsSettings      `json:"settings"`
+	Authors            []string            `json:"authors"`
+	Copyright          string              `json:"copyright"`
+	CCLI               string              `json:"ccli"`
+	Key                string              `json:"key"`
+	Tempo              int                 `json:"tempo" validate:"min=0,max=400"`
+	Tags               []string            `json:"tags"`
+}
+
+// RequestLyricsSongs filters and sorts the song list. Query matches the
+// title, authors and CCLI number; Tag may list several tags, comma
+// separated, that a song must all have. Sort is updated (the default),
+// title, key or tempo.
+type RequestLyricsSongs struct {
+	Query    string `json:"q"`
+	Key      string `json:"key"`
+	Tag      string `json:"tag"`
+	TempoMin int    `json:"tempoMin" validate:"min=0"`
+	TempoMax int    `json:"tempoMax" validate:"min=0"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=updated title key tempo"`
+	Order    string `json:"order" validate:"omitempty,oneof=asc desc"`
+}
+
+// LyricsTag is a tag of the library with the number of songs that have it.
+type LyricsTag struct {
+	Name  string `json:"name"`
+	Songs int    `json:"songs"`
+}
+
+// RequestLyricsSlides asks for the slides of a song in the order of one of
+// its arrangements, the default one when Arrangement is empty.
+type RequestLyricsSlides struct {
+	ID          string `json:"id" validate:"required"`
+	Arrangement string `json:"arrangement"`
+}
+
+// LyricsSlides is a song laid out for projection: its segments in the order
+// of an arrangement, a repeated segment showing up every time it is sung.
+// Arrangement is empty for a song without arrangements.
+type LyricsSlides struct {
+	SongID      string          `json:"songId"`
+	Arrangement string          `json:"arrangement,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Slides      []LyricsSegment `json:"slides"`
+	Credits     string          `json:"credits,omitempty"`
+}
+
+// RequestLyricsSegment asks for the segments of a pasted text. Stanzas
+// longer than MaxLines are split into several slides.
+type RequestLyricsSegment struct {
+	Text     string `json:"text" validate:"required"`
+	MaxLines int    `json:"maxLines" validate:"max=20"`
+}
+
+// LyricsSegmentation is the proposed split of a pasted text: every segment
+// once, and Sequence, the order they are sung in, repeats included.
+type LyricsSegmentation struct {
 	Segments []LyricsSegment `json:"segments"`
-	Settings LyricsSettings  `json:"settings"`
+	Sequence []string        `json:"sequence"`
+}
+
+// LyricsImportFile is one song read from an import. Error is set instead
+// of Song when the file could not be read.
+type LyricsImportFile struct {
+	File  string
+	Song  *LyricsSongPayload
+	Error string
+}
+
+// LyricsImportItem reports what happened to one song of an import: Status
+// is imported, merged or skipped, with the Reason when it was skipped.
+type LyricsImportItem struct {
+	File   string `json:"file"`
+	Title  string `json:"title,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type LyricsImportReport struct {
+	Imported int                `json:"imported"`
+	Merged   int                `json:"merged"`
+	Skipped  int                `json:"skipped"`
+	Songs    []LyricsImportItem `json:"songs"`
+}
+
+// RequestLyricsChords renders the chords of a song. Key transposes it to
+// that key, else Transpose moves it by semitones; with a Capo the chords
+// are written as played on the capoed guitar. The sections follow
+// Arrangement, or the default arrangement.
+type RequestLyricsChords struct {
+	ID          string `json:"id" validate:"required"`
+	Format      string `json:"format"`
+	Key         string `json:"key"`
+	Transpose   int    `json:"transpose" validate:"min=-11,max=11"`
+	Capo        int    `json:"capo" validate:"min=0,max=11"`
+	Arrangement string `json:"arrangement"`
+}
+
+// RequestLyricsExport selects the songs of an export, all of them when IDs
+// is empty.
+type RequestLyricsExport struct {
+	ID     string `json:"id"`
+	IDs    string `json:"ids"`
+	Format string `json:"format"`
 }
@@ -0,0 +1,47 @@
+package entities
+
+type RequestParallel struct {
+	Ref      string `json:"ref" validate:"required"`
+	Versions string `json:"versions" validate:"required"`
+}
+
+// VersificationMapping moves verses FirstVerse..LastVerse of chapters
+// FirstChapter..LastChapter of a scheme onto the standard numbering by
+// adding ChapterOffset and VerseOffset.
+type VersificationMapping struct {
+	Versification string `json:"versification"`
+	Book          string `json:"book"`
+	FirstChapter  int    `json:"firstChapter"`
+	LastChapter   int    `json:"lastChapter"`
+	FirstVerse    int    `json:"firstVerse"`
+	LastVerse     int    `json:"lastVerse"`
+	ChapterOffset int    `json:"chapterOffset"`
+	VerseOffset   int    `json:"verseOffset"`
+}
+
+// ParallelPassage is one passage read from several bibles. Bibles follows
+// the requested order and every row of Verses has one text per bible, in
+// the same order.
+type ParallelPassage struct {
+	Reference string          `json:"reference"`
+	Bibles    []Bible         `json:"bibles"`
+	Verses    []ParallelVerse `json:"verses"`
+}
+
+// ParallelVerse is a verse numbered as in the first bible.
+type ParallelVerse struct {
+	Book     string         `json:"book"`
+	Chapter  int            `json:"chapter"`
+	Verse    int            `json:"verse"`
+	Research string         `json:"research,omitempty"`
+	Texts    []ParallelText `json:"texts"`
+}
+
+// ParallelText is the matching verse of one bible under its own numbering.
+// Text is empty when that bible has no such verse.
+type ParallelText struct {
+	Version int    `json:"version"`
+	Chapter int    `json:"chapter"`
+	Verse   int    `json:"verse"`
+	Text    string `json:"text"`
+}
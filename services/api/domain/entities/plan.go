@@ -0,0 +1,79 @@
+package entities
+
+// ReadingPlan assigns readings to numbered days. With a StartDate
+// (YYYY-MM-DD) day 1 is that date; without one day 1 is January 1st, for
+// plans that repeat every year. Days is the last day of the schedule.
+type ReadingPlan struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	StartDate   string           `json:"startDate"`
+	Days        int              `json:"days"`
+	Schedule    []ReadingPlanDay `json:"schedule"`
+	CreatedAt   string           `json:"createdAt"`
+	UpdatedAt   string           `json:"updatedAt"`
+}
+
+// ReadingPlanDay holds the readings of one day in their canonical form,
+// e.g. "Génesis 1-2".
+type ReadingPlanDay struct {
+	Day      int      `json:"day"`
+	Readings []string `json:"readings"`
+}
+
+type ReadingPlanSummary struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+	Days      int    `json:"days"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// ReadingPlanImport is a parsed plan file, ready to be stored.
+type ReadingPlanImport struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	StartDate   string           `json:"startDate"`
+	Schedule    []ReadingPlanDay `json:"schedule"`
+}
+
+type RequestPlanToday struct {
+	ID      string `json:"id" validate:"required"`
+	Date    string `json:"date"`
+	Version int    `json:"version"`
+}
+
+// ReadingPlanToday is the day of a plan that falls on Date, with the text of
+// its readings. Readings is empty on a rest day.
+type ReadingPlanToday struct {
+	PlanID   string        `json:"planId"`
+	Name     string        `json:"name"`
+	Date     string        `json:"date"`
+	Day      int           `json:"day"`
+	Days     int           `json:"days"`
+	Version  int           `json:"version"`
+	Readings []PlanReading `json:"readings"`
+}
+
+// PlanReading is one reading with its text, or the reason the version
+// cannot show it.
+type PlanReading struct {
+	Reference string   `json:"reference"`
+	Passage   *Passage `json:"passage,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+type RequestVerseOfTheDay struct {
+	Date    string `json:"date"`
+	Version int    `json:"version"`
+}
+
+// VerseOfTheDay is the curated verse chosen for Date.
+type VerseOfTheDay struct {
+	Date      string  `json:"date"`
+	Reference string  `json:"reference"`
+	Book      string  `json:"book"`
+	Chapter   int     `json:"chapter"`
+	Verses    []Verse `json:"verses"`
+}
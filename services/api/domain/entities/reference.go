@@ -0,0 +1,78 @@
+package entities
+
+type RequestReference struct {
+	Ref     string `json:"ref" validate:"required"`
+	Version int    `json:"version"`
+}
+
+// ReferenceRange is a span of verses inside one book, from
+// StartChapter:StartVerse to EndChapter:EndVerse inclusive. Reference is the
+// canonical display form, e.g. "Romanos 8:28-9:5".
+type ReferenceRange struct {
+	Book         string `json:"book"`
+	BookName     string `json:"bookName"`
+	StartChapter int    `json:"startChapter"`
+	StartVerse   int    `json:"startVerse"`
+	EndChapter   int    `json:"endChapter"`
+	EndVerse     int    `json:"endVerse"`
+	Reference    string `json:"reference"`
+}
+
+type RequestPassage struct {
+	Ref     string `json:"ref" validate:"required"`
+	Version int    `json:"version"`
+}
+
+// Passage is the text of one or more ranges. Reference is the display form
+// of the whole request, e.g. "Romanos 8:28-9:5".
+type Passage struct {
+	Reference   string           `json:"reference"`
+	Ranges      []ReferenceRange `json:"ranges"`
+	Chapters    []PassageChapter `json:"chapters"`
+	Translation *Translation     `json:"translation,omitempty"`
+}
+
+// PassageChapter holds the verses of a passage that fall in one chapter.
+// Research is the chapter heading and is only set when verse 1 is included.
+type PassageChapter struct {
+	Book     string  `json:"book"`
+	Chapter  int     `json:"chapter"`
+	Name     string  `json:"name"`
+	Research string  `json:"research,omitempty"`
+	Verses   []Verse `json:"verses"`
+}
+
+// RequestSlides asks for a passage cut into projection slides. The budget
+// is MaxChars, or MaxLines of CharsPerLine; FontSize, LineHeight, MaxWidth
+// and Height (pixels, as in the verse styles) estimate the missing parts.
+type RequestSlides struct {
+	Ref          string  `json:"ref" validate:"required"`
+	Version      int     `json:"version"`
+	MaxChars     int     `json:"maxChars"`
+	MaxLines     int     `json:"maxLines"`
+	CharsPerLine int     `json:"charsPerLine"`
+	FontSize     float64 `json:"fontSize"`
+	LineHeight   float64 `json:"lineHeight"`
+	MaxWidth     float64 `json:"maxWidth"`
+	Height       float64 `json:"height"`
+}
+
+// PassageSlides is a passage cut into slides, in reading order.
+type PassageSlides struct {
+	Reference   string       `json:"reference"`
+	Slides      []Slide      `json:"slides"`
+	Translation *Translation `json:"translation,omitempty"`
+}
+
+// Slide is one chunk of a verse. Label is the reference shown with it,
+// numbered when the verse takes several slides: "Juan 3:16 (1/2)".
+type Slide struct {
+	Label     string `json:"label"`
+	Reference string `json:"reference"`
+	Book      string `json:"book"`
+	Chapter   int    `json:"chapter"`
+	Verse     int    `json:"verse"`
+	Part      int    `json:"part"`
+	Parts     int    `json:"parts"`
+	Text      string `json:"text"`
+}
@@ -4,6 +4,8 @@ type RequestSearch struct {
 	Query   string `json:"q" validate:"required"`
 	Version int    `json:"version"`
 	Limit   int    `json:"limit"`
+	Offset  int    `json:"offset"`
+	Fuzzy   bool   `json:"fuzzy"`
 }
 
 type VerseMatch struct {
@@ -11,4 +13,58 @@ type VerseMatch struct {
 	Chapter int    `json:"chapter"`
 	Verse   int    `json:"verse"`
 	Text    string `json:"text"`
+	Snippet string `json:"snippet"`
+}
+
+// SearchResult is one page of a verse search. NextOffset is the offset of
+// the following page and stays null on the last one; Facets count the hits
+// of the whole search per book. Approximate is set when the results come
+// from the corrected query in Suggestions rather than the words as typed.
+type SearchResult struct {
+	Total       int                `json:"total"`
+	Offset      int                `json:"offset"`
+	Limit       int                `json:"limit"`
+	NextOffset  *int               `json:"nextOffset"`
+	Results     []VerseMatch       `json:"results"`
+	Facets      []SearchFacet      `json:"facets"`
+	Approximate bool               `json:"approximate"`
+	Suggestions []SearchSuggestion `json:"suggestions,omitempty"`
+}
+
+type SearchFacet struct {
+	Book  string `json:"book"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// SearchQuery is a parsed verse search. A verse matches when it contains a
+// term of every group, none of the excluded terms, and belongs to a book of
+// every list in Books, one list per filter.
+type SearchQuery struct {
+	Groups   [][]SearchTerm `json:"groups"`
+	Excluded []SearchTerm   `json:"excluded,omitempty"`
+	Books    [][]string     `json:"books,omitempty"`
+	// Fuzzy also matches close spellings of words found in the verses.
+	Fuzzy bool `json:"fuzzy,omitempty"`
+}
+
+// SearchTerm is a single word or, with several Words, an exact phrase.
+// Prefix lets the last word match longer words that start with it.
+type SearchTerm struct {
+	Words  []string `json:"words"`
+	Prefix bool     `json:"prefix,omitempty"`
+}
+
+// SearchCandidate is a word of the verse vocabulary close to a searched one.
+// Verses counts the verses that contain it.
+type SearchCandidate struct {
+	Term     string `json:"term"`
+	Distance int    `json:"distance"`
+	Verses   int    `json:"verses"`
+}
+
+// SearchSuggestion lists the corrections tried for a misspelled word.
+type SearchSuggestion struct {
+	Term        string   `json:"term"`
+	Suggestions []string `json:"suggestions"`
 }
@@ -0,0 +1,46 @@
+package entities
+
+// TopicImport is one topic of a topical index with its references in the
+// standard numbering.
+type TopicImport struct {
+	Name       string           `json:"name"`
+	References []ReferenceRange `json:"references"`
+}
+
+// Topic is a search result; References is how many references it has.
+type Topic struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	References int    `json:"references"`
+}
+
+type RequestTopicSearch struct {
+	Query string `json:"q" validate:"required"`
+	Limit int    `json:"limit"`
+}
+
+type RequestTopicVerses struct {
+	ID      int64 `json:"id" validate:"required"`
+	Version int   `json:"version"`
+	Limit   int   `json:"limit"`
+	Offset  int   `json:"offset"`
+}
+
+// TopicVerses is one page of the references of a topic with their text.
+// Total, Offset and NextOffset count references; a reference the version
+// lacks is left out of Passages.
+type TopicVerses struct {
+	ID         int64          `json:"id"`
+	Name       string         `json:"name"`
+	Total      int            `json:"total"`
+	Offset     int            `json:"offset"`
+	Limit      int            `json:"limit"`
+	NextOffset *int           `json:"nextOffset"`
+	Passages   []TopicPassage `json:"passages"`
+}
+
+type TopicPassage struct {
+	Reference string         `json:"reference"`
+	Range     ReferenceRange `json:"range"`
+	Verses    []Verse        `json:"verses"`
+}
@@ -2,20 +2,48 @@ package actions
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"services/api/domain/consts"
 	"services/api/domain/entities"
+	"services/api/internal/bibleref"
 	"services/api/internal/infrastructure"
+	"services/api/internal/searchquery"
+	"services/api/internal/slides"
+	"sort"
+	"strings"
+	"time"
 )
 
 //go:generate mockgen -source=./bible.go -destination=./mocks/bible.go -package=mocks
 
+// ErrBibleNameRequired is returned when a version would be left without a
+// name or an abbreviation to show.
+var ErrBibleNameRequired = errors.New("bible name is required")
+
+// ErrIncompleteBible is returned, with the validation report, when an import
+// lacks books, chapters or verses and was not forced.
+var ErrIncompleteBible = errors.New("bible is incomplete")
+
 type BibleAction struct {
 	Db infrastructure.DatabaseGetter
 }
 
 type BibleActionInterface interface {
+	ListBibles(ctx context.Context) ([]entities.Bible, error)
+	ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error)
+	UpdateBible(ctx context.Context, metadata entities.BibleMetadata) (*entities.Bible, error)
 	VerifyBibleReference(ctx context.Context, request entities.RequestBible) (bool, error)
 	GetBibleReferences(ctx context.Context, request entities.RequestBible) (*entities.Chapter, error)
-	SearchVerses(ctx context.Context, terms []string, version int, limit int) ([]entities.VerseMatch, error)
+	ParseReference(ctx context.Context, ref string, version int) ([]entities.ReferenceRange, error)
+	GetPassage(ctx context.Context, ref string, version int) (*entities.Passage, error)
+	GetParallelPassage(ctx context.Context, ref string, versions []int) (*entities.ParallelPassage, error)
+	GetPassageSlides(ctx context.Context, request entities.RequestSlides) (*entities.PassageSlides, error)
+	GetRelatedVerses(ctx context.Context, request entities.RequestRelated) (*entities.RelatedVerses, error)
+	ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error)
+	GetVerseOfTheDay(ctx context.Context, date time.Time, version int) (*entities.VerseOfTheDay, error)
+	SearchVerses(ctx context.Context, query entities.SearchQuery, version int, limit int, offset int) (*entities.SearchResult, error)
+	ImportBible(ctx context.Context, bible entities.BibleImport, options entities.BibleImportOptions) (*entities.BibleImportResult, error)
 }
 
 func NewBibleAction(db infrastructure.DatabaseGetter) BibleActionInterface {
@@ -24,14 +52,563 @@ func NewBibleAction(db infrastructure.DatabaseGetter) BibleActionInterface {
 	}
 }
 
+func (b *BibleAction) ListBibles(ctx context.Context) ([]entities.Bible, error) {
+	return b.Db.ListBibles(ctx)
+}
+
+// UpdateBible sets the name, abbreviation, language, license and
+// attribution of an installed version, for versions imported without them.
+// The fields metadata leaves nil keep their stored value.
+func (b *BibleAction) UpdateBible(ctx context.Context, metadata entities.BibleMetadata) (*entities.Bible, error) {
+	bible, err := b.Db.GetBible(ctx, metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+	bible.Name = updatedField(bible.Name, metadata.Name)
+	bible.Abbreviation = updatedField(bible.Abbreviation, metadata.Abbreviation)
+	bible.Language = updatedField(bible.Language, metadata.Language)
+	bible.License = updatedField(bible.License, metadata.License)
+	bible.Attribution = updatedField(bible.Attribution, metadata.Attribution)
+	if bible.Name == "" {
+		bible.Name = bible.Abbreviation
+	}
+	if bible.Name == "" {
+		return nil, ErrBibleNameRequired
+	}
+	if err := b.Db.UpdateBible(ctx, *bible); err != nil {
+		return nil, err
+	}
+	return b.Db.GetBibleCatalogEntry(ctx, bible.ID)
+}
+
+// updatedField returns value trimmed, or current when value was left out.
+func updatedField(current string, value *string) string {
+	if value == nil {
+		return current
+	}
+	return strings.TrimSpace(*value)
+}
+
+// translation tells which version a text comes from, with the line to
+// project next to it.
+func translation(bible *entities.Bible) *entities.Translation {
+	attribution := bible.Attribution
+	if attribution == "" {
+		attribution = bible.Abbreviation
+	}
+	if attribution == "" {
+		attribution = bible.Name
+	}
+	return &entities.Translation{
+		ID:           bible.ID,
+		Name:         bible.Name,
+		Abbreviation: bible.Abbreviation,
+		Language:     bible.Language,
+		License:      bible.License,
+		Attribution:  attribution,
+	}
+}
+
+// ListBooks returns the book catalog of a version, or sql.ErrNoRows when
+// the version does not exist.
+func (b *BibleAction) ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error) {
+	if _, err := b.Db.GetBible(ctx, version); err != nil {
+		return nil, err
+	}
+	return b.Db.ListBooks(ctx, version)
+}
+
+// VerifyBibleReference accepts the book by any of its names or aliases,
+// like "Gn", "Genesis" or "Gênesis".
 func (b *BibleAction) VerifyBibleReference(ctx context.Context, request entities.RequestBible) (bool, error) {
+	request.Book = resolveBook(request.Book)
 	return b.Db.VerifyBibleReference(ctx, request)
 }
 
+// GetBibleReferences returns the verses of a chapter together with the
+// version they were read from.
 func (b *BibleAction) GetBibleReferences(ctx context.Context, request entities.RequestBible) (*entities.Chapter, error) {
-	return b.Db.GetBibleReferences(ctx, request)
+	bible, err := b.Db.GetBible(ctx, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	request.Book = resolveBook(request.Book)
+	chapter, err := b.Db.GetBibleReferences(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	chapter.Translation = translation(bible)
+	return chapter, nil
+}
+
+// resolveBook turns a book name or alias into its slug. A name that cannot
+// be resolved is passed on unchanged, so the lookup simply finds nothing.
+func resolveBook(name string) string {
+	if slug, err := bibleref.LookupBook(name); err == nil {
+		return slug
+	}
+	return name
+}
+
+// ParseReference reads a free-form reference and checks every range against
+// the chapter sizes of the requested version. Invalid input is reported as a
+// *bibleref.Error.
+func (b *BibleAction) ParseReference(ctx context.Context, ref string, version int) ([]entities.ReferenceRange, error) {
+	ranges, _, err := b.parseReference(ctx, ref, version)
+	return ranges, err
+}
+
+// GetPassage returns the text of every range in ref with the version it was
+// read from.
+func (b *BibleAction) GetPassage(ctx context.Context, ref string, version int) (*entities.Passage, error) {
+	bible, err := b.Db.GetBible(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	passage, err := b.passage(ctx, ref, bible.ID)
+	if err != nil {
+		return nil, err
+	}
+	passage.Translation = translation(bible)
+	return passage, nil
+}
+
+// passage reads the text of every range in ref. Ranges that land in the
+// same chapter, like "Romanos 8:31, 28", share one chapter group, with its
+// verses in order and read once. Books keep the order ref names them in,
+// and the chapters of a book go in order too.
+func (b *BibleAction) passage(ctx context.Context, ref string, version int) (*entities.Passage, error) {
+	ranges, display, err := b.parseReference(ctx, ref, version)
+	if err != nil {
+		return nil, err
+	}
+
+	passage := &entities.Passage{Reference: display, Ranges: ranges, Chapters: []entities.PassageChapter{}}
+	books := map[string]int{}
+	groups := map[string]int{}
+	for _, reference := range ranges {
+		chapters, err := b.Db.GetPassage(ctx, version, reference)
+		if err != nil {
+			return nil, err
+		}
+		for _, chapter := range chapters {
+			if _, ok := books[chapter.Book]; !ok {
+				books[chapter.Book] = len(books)
+			}
+			key := fmt.Sprintf("%s %d", chapter.Book, chapter.Chapter)
+			i, ok := groups[key]
+			if !ok {
+				groups[key] = len(passage.Chapters)
+				passage.Chapters = append(passage.Chapters, chapter)
+				continue
+			}
+			group := &passage.Chapters[i]
+			group.Verses = append(group.Verses, chapter.Verses...)
+			if group.Research == "" {
+				group.Research = chapter.Research
+			}
+		}
+	}
+
+	sort.SliceStable(passage.Chapters, func(i, j int) bool {
+		left, right := passage.Chapters[i], passage.Chapters[j]
+		if left.Book != right.Book {
+			return books[left.Book] < books[right.Book]
+		}
+		return left.Chapter < right.Chapter
+	})
+	for i := range passage.Chapters {
+		passage.Chapters[i].Verses = sortedVerses(passage.Chapters[i].Verses)
+	}
+	return passage, nil
+}
+
+// sortedVerses orders the verses of a chapter and drops the ones read twice
+// by overlapping ranges.
+func sortedVerses(verses []entities.Verse) []entities.Verse {
+	sort.SliceStable(verses, func(i, j int) bool { return verses[i].Index < verses[j].Index })
+	unique := verses[:0]
+	for _, verse := range verses {
+		if len(unique) == 0 || unique[len(unique)-1].Index != verse.Index {
+			unique = append(unique, verse)
+		}
+	}
+	return unique
+}
+
+// GetPassageSlides reads a passage and cuts every verse into slides that
+// fit the budget of the request.
+func (b *BibleAction) GetPassageSlides(ctx context.Context, request entities.RequestSlides) (*entities.PassageSlides, error) {
+	passage, err := b.GetPassage(ctx, request.Ref, request.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := slides.NewBudget(
+		slides.Budget{MaxChars: request.MaxChars, MaxLines: request.MaxLines, CharsPerLine: request.CharsPerLine},
+		slides.Metrics{FontSize: request.FontSize, LineHeight: request.LineHeight, Width: request.MaxWidth, Height: request.Height},
+	)
+
+	result := &entities.PassageSlides{Reference: passage.Reference, Slides: []entities.Slide{}, Translation: passage.Translation}
+	for _, chapter := range passage.Chapters {
+		for _, verse := range chapter.Verses {
+			reference := bibleref.Format(entities.ReferenceRange{
+				Book:         chapter.Book,
+				StartChapter: chapter.Chapter,
+				StartVerse:   verse.Index,
+				EndChapter:   chapter.Chapter,
+				EndVerse:     max(verse.Index, verse.Last),
+			})
+			chunks := slides.Split(verse.Text, budget)
+			for i, chunk := range chunks {
+				result.Slides = append(result.Slides, entities.Slide{
+					Label:     slides.Label(reference, i+1, len(chunks)),
+					Reference: reference,
+					Book:      chapter.Book,
+					Chapter:   chapter.Chapter,
+					Verse:     verse.Index,
+					Part:      i + 1,
+					Parts:     len(chunks),
+					Text:      chunk,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// parallelChapter is one chapter of one bible, kept while aligning a
+// parallel passage.
+type parallelChapter struct {
+	verses  []entities.Verse
+	byIndex map[int]string
+}
+
+// GetParallelPassage reads ref from every bible in versions, aligned verse by
+// verse. The reference and the rows follow the numbering of the first bible;
+// each verse goes through the standard numbering to find its counterpart in
+// the others, so Salmos 23 lines up with Salmos 22 of a Septuagint numbered
+// bible.
+func (b *BibleAction) GetParallelPassage(ctx context.Context, ref string, versions []int) (*entities.ParallelPassage, error) {
+	bibles := make([]entities.Bible, 0, len(versions))
+	schemes := make([]bibleref.Versification, 0, len(versions))
+	for _, version := range versions {
+		bible, err := b.Db.GetBible(ctx, version)
+		if err != nil {
+			return nil, err
+		}
+		mappings, err := b.Db.GetVersificationMappings(ctx, bible.Versification)
+		if err != nil {
+			return nil, err
+		}
+		bibles = append(bibles, *bible)
+		schemes = append(schemes, bibleref.Versification(mappings))
+	}
+	if len(bibles) == 0 {
+		return nil, errors.New("no versions requested")
+	}
+
+	ranges, display, err := b.parseReference(ctx, ref, bibles[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	type chapterKey struct {
+		version int
+		book    string
+		chapter int
+	}
+	loaded := map[chapterKey]*parallelChapter{}
+	load := func(version int, book string, chapter int) (*parallelChapter, error) {
+		key := chapterKey{version: version, book: book, chapter: chapter}
+		if found, ok := loaded[key]; ok {
+			return found, nil
+		}
+		found, err := b.Db.GetBibleReferences(ctx, entities.RequestBible{Book: book, Chapter: chapter, Version: version})
+		if err != nil {
+			return nil, err
+		}
+		item := &parallelChapter{verses: found.Verses, byIndex: make(map[int]string, len(found.Verses))}
+		for _, verse := range found.Verses {
+			for index := verse.Index; index <= max(verse.Index, verse.Last); index++ {
+				item.byIndex[index] = verse.Text
+			}
+		}
+		loaded[key] = item
+		return item, nil
+	}
+
+	passage := &entities.ParallelPassage{Reference: display, Bibles: bibles, Verses: []entities.ParallelVerse{}}
+	for _, reference := range ranges {
+		for chapter := reference.StartChapter; chapter <= reference.EndChapter; chapter++ {
+			primary, err := load(bibles[0].ID, reference.Book, chapter)
+			if err != nil {
+				return nil, err
+			}
+			for _, verse := range primary.verses {
+				if (chapter == reference.StartChapter && max(verse.Index, verse.Last) < reference.StartVerse) ||
+					(chapter == reference.EndChapter && verse.Index > reference.EndVerse) {
+					continue
+				}
+
+				row := entities.ParallelVerse{
+					Book:     reference.Book,
+					Chapter:  chapter,
+					Verse:    verse.Index,
+					Research: verse.Research,
+					Texts:    make([]entities.ParallelText, 0, len(bibles)),
+				}
+				standardChapter, standardVerse := schemes[0].ToStandard(reference.Book, chapter, verse.Index)
+				for i, bible := range bibles {
+					localChapter, localVerse := chapter, verse.Index
+					if i > 0 {
+						localChapter, localVerse = schemes[i].FromStandard(reference.Book, standardChapter, standardVerse)
+					}
+					other, err := load(bible.ID, reference.Book, localChapter)
+					if err != nil {
+						return nil, err
+					}
+					row.Texts = append(row.Texts, entities.ParallelText{
+						Version: bible.ID,
+						Chapter: localChapter,
+						Verse:   localVerse,
+						Text:    other.byIndex[localVerse],
+					})
+				}
+				passage.Verses = append(passage.Verses, row)
+			}
+		}
+	}
+	return passage, nil
+}
+
+// defaultRelated and maxRelated bound how many linked passages one request
+// returns.
+const (
+	defaultRelated = 10
+	maxRelated     = 50
+)
+
+// GetRelatedVerses returns the passages linked to a verse, with their text in
+// request.Version. Links are stored in the standard numbering, so the verse
+// and every link go through the versification of the version. Links to
+// verses the version lacks are left out.
+func (b *BibleAction) GetRelatedVerses(ctx context.Context, request entities.RequestRelated) (*entities.RelatedVerses, error) {
+	bible, err := b.Db.GetBible(ctx, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := b.Db.GetVersificationMappings(ctx, bible.Versification)
+	if err != nil {
+		return nil, err
+	}
+	scheme := bibleref.Versification(mappings)
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultRelated
+	}
+	limit = min(limit, maxRelated)
+
+	chapter, verse := scheme.ToStandard(request.Book, request.Chapter, request.Verse)
+	refs, err := b.Db.GetCrossReferences(ctx, request.Book, chapter, verse, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	source := entities.ReferenceRange{
+		Book:         request.Book,
+		StartChapter: request.Chapter,
+		StartVerse:   request.Verse,
+		EndChapter:   request.Chapter,
+		EndVerse:     request.Verse,
+	}
+	related := &entities.RelatedVerses{Reference: bibleref.Format(source), Related: []entities.RelatedPassage{}}
+	for _, ref := range refs {
+		target := ref.Target
+		target.StartChapter, target.StartVerse = scheme.FromStandard(target.Book, target.StartChapter, target.StartVerse)
+		target.EndChapter, target.EndVerse = scheme.FromStandard(target.Book, target.EndChapter, target.EndVerse)
+		target.Reference = bibleref.Format(target)
+
+		chapters, err := b.Db.GetPassage(ctx, bible.ID, target)
+		if err != nil {
+			return nil, err
+		}
+		verses := []entities.Verse{}
+		for _, chapter := range chapters {
+			verses = append(verses, chapter.Verses...)
+		}
+		if len(verses) == 0 {
+			continue
+		}
+		related.Related = append(related.Related, entities.RelatedPassage{
+			Reference: target.Reference,
+			Range:     target,
+			Votes:     ref.Votes,
+			Verses:    verses,
+		})
+	}
+	return related, nil
+}
+
+// ImportCrossReferences replaces the stored cross references.
+func (b *BibleAction) ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error) {
+	return b.Db.ImportCrossReferences(ctx, refs)
+}
+
+// GetVerseOfTheDay picks the entry of consts.DailyVerses for date, the same
+// one for every client on that day, and reads it with GetBibleReferences in
+// the numbering of the version. An entry the version lacks passes the turn
+// to the next one.
+func (b *BibleAction) GetVerseOfTheDay(ctx context.Context, date time.Time, version int) (*entities.VerseOfTheDay, error) {
+	bible, err := b.Db.GetBible(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := b.Db.GetVersificationMappings(ctx, bible.Versification)
+	if err != nil {
+		return nil, err
+	}
+	scheme := bibleref.Versification(mappings)
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	count := len(consts.DailyVerses)
+	for i := 0; i < count; i++ {
+		ranges, err := bibleref.Parse(consts.DailyVerses[(int(day)+i)%count])
+		if err != nil {
+			return nil, err
+		}
+		target := ranges[0]
+		target.StartChapter, target.StartVerse = scheme.FromStandard(target.Book, target.StartChapter, target.StartVerse)
+		target.EndChapter, target.EndVerse = scheme.FromStandard(target.Book, target.EndChapter, target.EndVerse)
+		if target.EndChapter != target.StartChapter {
+			target.EndChapter, target.EndVerse = target.StartChapter, target.StartVerse
+		}
+
+		chapter, err := b.Db.GetBibleReferences(ctx, entities.RequestBible{
+			Version: bible.ID,
+			Book:    target.Book,
+			Chapter: target.StartChapter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		verses := versesBetween(chapter.Verses, target.StartVerse, target.EndVerse)
+		if len(verses) == 0 {
+			continue
+		}
+		return &entities.VerseOfTheDay{
+			Date:      date.Format("2006-01-02"),
+			Reference: bibleref.Format(target),
+			Book:      target.Book,
+			Chapter:   target.StartChapter,
+			Verses:    verses,
+		}, nil
+	}
+	return nil, fmt.Errorf("bible %d has none of the daily verses", bible.ID)
+}
+
+// versesBetween keeps the verses numbered first to last, and a bridge that
+// reaches into them. It goes by number rather than by position, since a
+// chapter may skip numbers.
+func versesBetween(verses []entities.Verse, first int, last int) []entities.Verse {
+	selected := []entities.Verse{}
+	for _, verse := range verses {
+		if max(verse.Index, verse.Last) >= first && verse.Index <= last {
+			selected = append(selected, verse)
+		}
+	}
+	return selected
+}
+
+// parseReference parses and checks ref, also returning its display form.
+// The display is built before Check so whole chapters stay "Juan 3".
+func (b *BibleAction) parseReference(ctx context.Context, ref string, version int) ([]entities.ReferenceRange, string, error) {
+	ranges, err := bibleref.Parse(ref)
+	if err != nil {
+		return nil, "", err
+	}
+	display := bibleref.FormatRanges(ranges)
+
+	sizes := map[string]map[int]int{}
+	for i := range ranges {
+		book := ranges[i].Book
+		if _, ok := sizes[book]; !ok {
+			bookSizes, err := b.Db.GetChapterSizes(ctx, version, book)
+			if err != nil {
+				return nil, "", err
+			}
+			sizes[book] = bookSizes
+		}
+		if err := bibleref.Check(&ranges[i], sizes[book]); err != nil {
+			return nil, "", err
+		}
+	}
+	return ranges, display, nil
+}
+
+// maxSearchCandidates is how many close vocabulary words stand in for a
+// misspelled one.
+const maxSearchCandidates = 3
+
+// SearchVerses runs the query as typed. When nothing matches, or always with
+// query.Fuzzy, it looks up close spellings of the words in the verse
+// vocabulary and runs the corrected query instead, reporting the corrections
+// in Suggestions.
+func (b *BibleAction) SearchVerses(ctx context.Context, query entities.SearchQuery, version int, limit int, offset int) (*entities.SearchResult, error) {
+	result, err := b.Db.SearchVerses(ctx, query, version, limit, offset)
+	if err != nil || (result.Total > 0 && !query.Fuzzy) {
+		return result, err
+	}
+
+	candidates := map[string][]entities.SearchCandidate{}
+	for word, prefix := range searchquery.Words(query) {
+		found, err := b.Db.GetSearchCandidates(ctx, version, word, prefix, maxSearchCandidates)
+		if err != nil {
+			return nil, err
+		}
+		candidates[word] = found
+	}
+
+	corrected, suggestions, changed := searchquery.Correct(query, candidates, query.Fuzzy)
+	if !changed {
+		return result, nil
+	}
+
+	approximate, err := b.Db.SearchVerses(ctx, corrected, version, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	approximate.Approximate = true
+	approximate.Suggestions = suggestions
+	return approximate, nil
+}
+
+// ImportBible checks the parsed bible against the expected versification and,
+// unless options.ValidateOnly is set, stores it as a new version. A bible that
+// lacks books, chapters or verses is only stored with options.Force; without
+// it the report comes back with ErrIncompleteBible.
+func (b *BibleAction) ImportBible(ctx context.Context, bible entities.BibleImport, options entities.BibleImportOptions) (*entities.BibleImportResult, error) {
+	validation, err := b.Db.ValidateBibleImport(ctx, bible)
+	if err != nil {
+		return nil, err
+	}
+	result := &entities.BibleImportResult{Validation: *validation}
+	if options.ValidateOnly {
+		return result, nil
+	}
+	if !options.Force && !importComplete(*validation) {
+		return result, ErrIncompleteBible
+	}
+
+	imported, err := b.Db.ImportBible(ctx, bible)
+	if err != nil {
+		return nil, err
+	}
+	result.Bible = imported
+	return result, nil
 }
 
-func (b *BibleAction) SearchVerses(ctx context.Context, terms []string, version int, limit int) ([]entities.VerseMatch, error) {
-	return b.Db.SearchVerses(ctx, terms, version, limit)
+func importComplete(validation entities.ImportValidation) bool {
+	return len(validation.MissingBooks) == 0 && len(validation.MissingChapters) == 0 && len(validation.MissingVerses) == 0
 }
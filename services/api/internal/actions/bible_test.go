@@ -0,0 +1,180 @@
+package actions_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/infrastructure/mocks"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBibleAction_GetPassage(t *testing.T) {
+	t.Run("should order the verses and chapters of ranges given out of order", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		f.expectBible(1)
+		f.db.EXPECT().GetChapterSizes(gomock.Any(), 1, "romanos").Return(map[int]int{8: 39, 9: 33}, nil)
+		f.expectPassages(4)
+
+		passage, err := f.action.GetPassage(context.Background(), "Rom 9:1; 8:31, 28, 30-31", 0)
+
+		require.NoError(t, err)
+		require.Len(t, passage.Chapters, 2)
+		assert.Equal(t, 8, passage.Chapters[0].Chapter)
+		assert.Equal(t, []int{28, 30, 31}, verseIndexes(passage.Chapters[0].Verses))
+		assert.Equal(t, 9, passage.Chapters[1].Chapter)
+		assert.Equal(t, []int{1}, verseIndexes(passage.Chapters[1].Verses))
+	})
+
+	t.Run("should keep the books in the order they are named", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		f.expectBible(1)
+		f.db.EXPECT().GetChapterSizes(gomock.Any(), 1, "romanos").Return(map[int]int{8: 39}, nil)
+		f.db.EXPECT().GetChapterSizes(gomock.Any(), 1, "juan").Return(map[int]int{3: 36}, nil)
+		f.expectPassages(2)
+
+		passage, err := f.action.GetPassage(context.Background(), "Rom 8:28; Juan 3:16", 0)
+
+		require.NoError(t, err)
+		require.Len(t, passage.Chapters, 2)
+		assert.Equal(t, "romanos", passage.Chapters[0].Book)
+		assert.Equal(t, "juan", passage.Chapters[1].Book)
+	})
+}
+
+func TestBibleAction_UpdateBible(t *testing.T) {
+	stored := entities.Bible{ID: 2, Name: "Nueva Versión Internacional", Abbreviation: "NVI", Language: "es", License: "© Biblica"}
+
+	t.Run("should keep the fields the request leaves out", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		bible := stored
+		f.db.EXPECT().GetBible(gomock.Any(), 2).Return(&bible, nil)
+		f.db.EXPECT().UpdateBible(gomock.Any(), entities.Bible{
+			ID:           2,
+			Name:         "Nueva Versión Internacional",
+			Abbreviation: "NVI",
+			Language:     "es",
+			License:      "© Biblica",
+			Attribution:  "NVI © Biblica",
+		}).Return(nil)
+		f.db.EXPECT().GetBibleCatalogEntry(gomock.Any(), 2).Return(&entities.Bible{ID: 2, Books: 66}, nil)
+
+		updated, err := f.action.UpdateBible(context.Background(), entities.BibleMetadata{ID: 2, Attribution: stringPointer(" NVI © Biblica ")})
+
+		require.NoError(t, err)
+		assert.Equal(t, 66, updated.Books)
+	})
+
+	t.Run("should clear a field sent empty and name the bible by its abbreviation", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		bible := stored
+		f.db.EXPECT().GetBible(gomock.Any(), 2).Return(&bible, nil)
+		f.db.EXPECT().UpdateBible(gomock.Any(), entities.Bible{ID: 2, Name: "NVI", Abbreviation: "NVI", Language: "es"}).Return(nil)
+		f.db.EXPECT().GetBibleCatalogEntry(gomock.Any(), 2).Return(&entities.Bible{ID: 2}, nil)
+
+		_, err := f.action.UpdateBible(context.Background(), entities.BibleMetadata{ID: 2, Name: stringPointer(""), License: stringPointer("")})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should refuse to leave the bible without a name", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		bible := stored
+		f.db.EXPECT().GetBible(gomock.Any(), 2).Return(&bible, nil)
+
+		_, err := f.action.UpdateBible(context.Background(), entities.BibleMetadata{ID: 2, Name: stringPointer(" "), Abbreviation: stringPointer("")})
+
+		assert.ErrorIs(t, err, actions.ErrBibleNameRequired)
+	})
+}
+
+func TestBibleAction_GetVerseOfTheDay(t *testing.T) {
+	// 2026-10-17 falls on "Salmos 147:3" of consts.DailyVerses.
+	date := time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should pick the verse by its number when the chapter skips one", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		f.expectBible(1)
+		f.db.EXPECT().GetVersificationMappings(gomock.Any(), gomock.Any()).Return(nil, nil)
+		f.db.EXPECT().
+			GetBibleReferences(gomock.Any(), entities.RequestBible{Version: 1, Book: "salmos", Chapter: 147}).
+			Return(&entities.Chapter{Verses: []entities.Verse{{Index: 1}, {Index: 3, Text: "Él sana a los quebrantados de corazón"}, {Index: 4}}}, nil)
+
+		verse, err := f.action.GetVerseOfTheDay(context.Background(), date, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10-17", verse.Date)
+		assert.Equal(t, "Salmos 147:3", verse.Reference)
+		assert.Equal(t, []int{3}, verseIndexes(verse.Verses))
+	})
+
+	t.Run("should pass the turn to the next entry when the version lacks the verse", func(t *testing.T) {
+		f := setupBibleActionFixture(t)
+		f.expectBible(1)
+		f.db.EXPECT().GetVersificationMappings(gomock.Any(), gomock.Any()).Return(nil, nil)
+		f.db.EXPECT().
+			GetBibleReferences(gomock.Any(), entities.RequestBible{Version: 1, Book: "salmos", Chapter: 147}).
+			Return(&entities.Chapter{Verses: []entities.Verse{{Index: 1}, {Index: 2}}}, nil)
+		f.db.EXPECT().
+			GetBibleReferences(gomock.Any(), entities.RequestBible{Version: 1, Book: "proverbios", Chapter: 3}).
+			DoAndReturn(func(_ context.Context, _ entities.RequestBible) (*entities.Chapter, error) {
+				verses := []entities.Verse{}
+				for index := 1; index <= 40; index++ {
+					verses = append(verses, entities.Verse{Index: index})
+				}
+				return &entities.Chapter{Verses: verses}, nil
+			})
+
+		verse, err := f.action.GetVerseOfTheDay(context.Background(), date, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Proverbios 3:5-6", verse.Reference)
+		assert.Equal(t, []int{5, 6}, verseIndexes(verse.Verses))
+	})
+}
+
+type bibleActionFixture struct {
+	db     *mocks.MockDatabaseGetter
+	action actions.BibleActionInterface
+}
+
+func setupBibleActionFixture(t *testing.T) *bibleActionFixture {
+	ctrl := gomock.NewController(t)
+	db := mocks.NewMockDatabaseGetter(ctrl)
+	return &bibleActionFixture{db: db, action: actions.NewBibleAction(db)}
+}
+
+func (a *bibleActionFixture) expectBible(id int) {
+	a.db.EXPECT().GetBible(gomock.Any(), gomock.Any()).Return(&entities.Bible{ID: id, Name: "Reina Valera 1960"}, nil)
+}
+
+// expectPassages answers every range, all within one chapter, with verses
+// whose text is their number.
+func (a *bibleActionFixture) expectPassages(times int) {
+	a.db.EXPECT().
+		GetPassage(gomock.Any(), 1, gomock.Any()).
+		Times(times).
+		DoAndReturn(func(_ context.Context, _ int, reference entities.ReferenceRange) ([]entities.PassageChapter, error) {
+			verses := []entities.Verse{}
+			for index := reference.StartVerse; index <= reference.EndVerse; index++ {
+				verses = append(verses, entities.Verse{Index: index, Text: strconv.Itoa(index)})
+			}
+			return []entities.PassageChapter{{Book: reference.Book, Chapter: reference.StartChapter, Verses: verses}}, nil
+		})
+}
+
+func verseIndexes(verses []entities.Verse) []int {
+	indexes := make([]int, 0, len(verses))
+	for _, verse := range verses {
+		indexes = append(indexes, verse.Index)
+	}
+	return indexes
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
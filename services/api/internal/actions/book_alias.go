@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/infrastructure"
+	"strings"
+)
+
+var (
+	// ErrBookAliasNotFound is returned when deleting an alias that does
+	// not exist or is one of the seeded ones.
+	ErrBookAliasNotFound = errors.New("book alias not found")
+	// ErrUnknownBook is returned when an alias names a book that cannot be
+	// resolved.
+	ErrUnknownBook = errors.New("unknown book")
+)
+
+// BookAliasConflictError reports an alias that already names a book.
+type BookAliasConflictError struct {
+	Alias string
+	Book  string
+}
+
+func (e *BookAliasConflictError) Error() string {
+	return fmt.Sprintf("%q already names %s", e.Alias, e.Book)
+}
+
+type BookAliasActionInterface interface {
+	ListBookAliases(ctx context.Context, book string) ([]entities.BookAlias, error)
+	AddBookAlias(ctx context.Context, payload entities.BookAliasPayload) (*entities.BookAlias, error)
+	DeleteBookAlias(ctx context.Context, id int64) error
+	LoadBookAliases(ctx context.Context) error
+}
+
+type BookAliasAction struct {
+	repo infrastructure.BookAliasRepository
+}
+
+func NewBookAliasAction(repo infrastructure.BookAliasRepository) BookAliasActionInterface {
+	return &BookAliasAction{repo: repo}
+}
+
+// ListBookAliases accepts the book by any of its names, so "Gn" lists the
+// aliases of Génesis.
+func (a *BookAliasAction) ListBookAliases(ctx context.Context, book string) ([]entities.BookAlias, error) {
+	if strings.TrimSpace(book) != "" {
+		slug, err := bibleref.LookupBook(book)
+		if err != nil {
+			return nil, ErrUnknownBook
+		}
+		book = slug
+	}
+	return a.repo.ListBookAliases(ctx, book)
+}
+
+// AddBookAlias stores a church's own alias and makes it usable right away
+// by the reference parser, the passage endpoints and the search filters.
+func (a *BookAliasAction) AddBookAlias(ctx context.Context, payload entities.BookAliasPayload) (*entities.BookAlias, error) {
+	slug, err := bibleref.LookupBook(payload.Book)
+	if err != nil {
+		return nil, ErrUnknownBook
+	}
+	alias := strings.Join(strings.Fields(payload.Alias), " ")
+	key := bibleref.BookKey(alias)
+	if key == "" {
+		return nil, ErrUnknownBook
+	}
+	if existing, ok := bibleref.ExactBook(alias); ok {
+		return nil, &BookAliasConflictError{Alias: alias, Book: existing}
+	}
+
+	stored, err := a.repo.AddBookAlias(ctx, entities.BookAlias{
+		Book:     slug,
+		Alias:    alias,
+		Language: strings.ToLower(strings.TrimSpace(payload.Language)),
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	return stored, a.LoadBookAliases(ctx)
+}
+
+func (a *BookAliasAction) DeleteBookAlias(ctx context.Context, id int64) error {
+	err := a.repo.DeleteBookAlias(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrBookAliasNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return a.LoadBookAliases(ctx)
+}
+
+// LoadBookAliases hands every stored alias to the book registry. It runs
+// at startup and after each change.
+func (a *BookAliasAction) LoadBookAliases(ctx context.Context) error {
+	aliases, err := a.repo.ListBookAliases(ctx, "")
+	if err != nil {
+		return err
+	}
+	bibleref.SetAliases(aliases)
+	return nil
+}
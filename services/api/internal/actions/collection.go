@@ -0,0 +1,106 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/infrastructure"
+	"strings"
+)
+
+// ErrCollectionNotFound tells a missing collection apart from a missing
+// bible, which both come from the database as sql.ErrNoRows.
+var ErrCollectionNotFound = errors.New("collection not found")
+
+type CollectionActionInterface interface {
+	ListCollections(ctx context.Context) ([]entities.VerseCollectionSummary, error)
+	GetCollection(ctx context.Context, id string) (*entities.VerseCollection, error)
+	UpsertCollection(ctx context.Context, payload entities.VerseCollectionPayload) (*entities.VerseCollection, error)
+	DeleteCollection(ctx context.Context, id string) error
+	GetCollectionText(ctx context.Context, id string, version int) (*entities.CollectionText, error)
+}
+
+type CollectionAction struct {
+	repo  infrastructure.CollectionRepository
+	bible BibleAction
+}
+
+// NewCollectionAction reads the text of the collections through the Bible
+// repository, with the same parsing and numbering as the passage endpoint.
+func NewCollectionAction(repo infrastructure.CollectionRepository, bible infrastructure.DatabaseGetter) CollectionActionInterface {
+	return &CollectionAction{repo: repo, bible: BibleAction{Db: bible}}
+}
+
+func (a *CollectionAction) ListCollections(ctx context.Context) ([]entities.VerseCollectionSummary, error) {
+	return a.repo.ListCollections(ctx)
+}
+
+func (a *CollectionAction) GetCollection(ctx context.Context, id string) (*entities.VerseCollection, error) {
+	collection, err := a.repo.GetCollection(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCollectionNotFound
+	}
+	return collection, err
+}
+
+// UpsertCollection stores every reference in its canonical form, so
+// "jn 3:16" is saved as "Juan 3:16". A reference that does not parse is
+// reported as a *bibleref.Error and nothing is saved.
+func (a *CollectionAction) UpsertCollection(ctx context.Context, payload entities.VerseCollectionPayload) (*entities.VerseCollection, error) {
+	items := make([]entities.CollectionItem, 0, len(payload.Items))
+	for _, item := range payload.Items {
+		ranges, err := bibleref.Parse(item.Reference)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, entities.CollectionItem{
+			Reference: bibleref.FormatRanges(ranges),
+			Note:      strings.TrimSpace(item.Note),
+		})
+	}
+	payload.Items = items
+	return a.repo.UpsertCollection(ctx, payload)
+}
+
+func (a *CollectionAction) DeleteCollection(ctx context.Context, id string) error {
+	return a.repo.DeleteCollection(ctx, id)
+}
+
+// GetCollectionText resolves every item of a collection in one version. An
+// item the version cannot show does not fail the whole collection; it comes
+// back with its error instead of text.
+func (a *CollectionAction) GetCollectionText(ctx context.Context, id string, version int) (*entities.CollectionText, error) {
+	collection, err := a.GetCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	bible, err := a.bible.Db.GetBible(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	text := &entities.CollectionText{
+		ID:          collection.ID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		Version:     bible.ID,
+		Items:       make([]entities.CollectionPassage, 0, len(collection.Items)),
+	}
+	for _, item := range collection.Items {
+		resolved := entities.CollectionPassage{Reference: item.Reference, Note: item.Note}
+		passage, err := a.bible.passage(ctx, item.Reference, bible.ID)
+		var refErr *bibleref.Error
+		switch {
+		case errors.As(err, &refErr):
+			resolved.Error = refErr.Error()
+		case err != nil:
+			return nil, err
+		default:
+			resolved.Passage = passage
+		}
+		text.Items = append(text.Items, resolved)
+	}
+	return text, nil
+}
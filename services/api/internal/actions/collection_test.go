@@ -0,0 +1,138 @@
+package actions_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/bibleref"
+	"services/api/internal/infrastructure/mocks"
+	"testing"
+)
+
+func TestCollectionAction_UpsertCollection(t *testing.T) {
+	t.Run("should store the references in their canonical form", func(t *testing.T) {
+		f := setupCollectionActionFixture(t)
+		f.repo.EXPECT().
+			UpsertCollection(gomock.Any(), entities.VerseCollectionPayload{
+				ID:   "sermon",
+				Name: "Sermón del domingo",
+				Items: []entities.CollectionItem{
+					{Reference: "Juan 3:16", Note: "Lectura"},
+					{Reference: "Romanos 8:28-30"},
+				},
+			}).
+			Return(&entities.VerseCollection{ID: "sermon"}, nil)
+
+		_, err := f.action.UpsertCollection(context.Background(), entities.VerseCollectionPayload{
+			ID:   "sermon",
+			Name: "Sermón del domingo",
+			Items: []entities.CollectionItem{
+				{Reference: "jn 3:16", Note: "  Lectura "},
+				{Reference: "Rom 8:28-30"},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should save nothing when a reference does not parse", func(t *testing.T) {
+		f := setupCollectionActionFixture(t)
+
+		_, err := f.action.UpsertCollection(context.Background(), entities.VerseCollectionPayload{
+			ID:    "sermon",
+			Name:  "Sermón del domingo",
+			Items: []entities.CollectionItem{{Reference: "Juan 3:16"}, {Reference: "Xyz 3"}},
+		})
+
+		var refErr *bibleref.Error
+		assert.ErrorAs(t, err, &refErr)
+	})
+}
+
+func TestCollectionAction_GetCollection(t *testing.T) {
+	t.Run("should report a missing collection", func(t *testing.T) {
+		f := setupCollectionActionFixture(t)
+		f.repo.EXPECT().GetCollection(gomock.Any(), "sermon").Return(nil, sql.ErrNoRows)
+
+		_, err := f.action.GetCollection(context.Background(), "sermon")
+
+		assert.ErrorIs(t, err, actions.ErrCollectionNotFound)
+	})
+}
+
+func TestCollectionAction_GetCollectionText(t *testing.T) {
+	t.Run("should resolve every item in the requested version", func(t *testing.T) {
+		f := setupCollectionActionFixture(t)
+		f.expectCollection(entities.CollectionItem{Reference: "Juan 3:16", Note: "Lectura"}, entities.CollectionItem{Reference: "Juan 3:17-18"})
+		f.bible.EXPECT().GetBible(gomock.Any(), 2).Return(&entities.Bible{ID: 2, Name: "Nueva Versión"}, nil)
+		f.bible.EXPECT().GetChapterSizes(gomock.Any(), 2, "juan").Return(map[int]int{3: 36}, nil).Times(2)
+		f.expectPassages(2, 2)
+
+		text, err := f.action.GetCollectionText(context.Background(), "sermon", 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, text.Version)
+		require.Len(t, text.Items, 2)
+		assert.Equal(t, "Lectura", text.Items[0].Note)
+		require.NotNil(t, text.Items[0].Passage)
+		assert.Equal(t, []int{16}, verseIndexes(text.Items[0].Passage.Chapters[0].Verses))
+		require.NotNil(t, text.Items[1].Passage)
+		assert.Equal(t, []int{17, 18}, verseIndexes(text.Items[1].Passage.Chapters[0].Verses))
+	})
+
+	t.Run("should keep an item the version cannot show with its error", func(t *testing.T) {
+		f := setupCollectionActionFixture(t)
+		f.expectCollection(entities.CollectionItem{Reference: "Romanos 16:25"}, entities.CollectionItem{Reference: "Romanos 8:28"})
+		f.bible.EXPECT().GetBible(gomock.Any(), 2).Return(&entities.Bible{ID: 2, Name: "Nueva Versión"}, nil)
+		f.bible.EXPECT().GetChapterSizes(gomock.Any(), 2, "romanos").Return(map[int]int{8: 39, 16: 24}, nil).Times(2)
+		f.expectPassages(2, 1)
+
+		text, err := f.action.GetCollectionText(context.Background(), "sermon", 2)
+
+		require.NoError(t, err)
+		require.Len(t, text.Items, 2)
+		assert.Nil(t, text.Items[0].Passage)
+		assert.NotEmpty(t, text.Items[0].Error)
+		assert.Equal(t, "Romanos 16:25", text.Items[0].Reference)
+		require.NotNil(t, text.Items[1].Passage)
+		assert.Empty(t, text.Items[1].Error)
+	})
+}
+
+type collectionActionFixture struct {
+	repo   *mocks.MockCollectionRepository
+	bible  *mocks.MockDatabaseGetter
+	action actions.CollectionActionInterface
+}
+
+func setupCollectionActionFixture(t *testing.T) *collectionActionFixture {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockCollectionRepository(ctrl)
+	bible := mocks.NewMockDatabaseGetter(ctrl)
+	return &collectionActionFixture{repo: repo, bible: bible, action: actions.NewCollectionAction(repo, bible)}
+}
+
+func (a *collectionActionFixture) expectCollection(items ...entities.CollectionItem) {
+	a.repo.EXPECT().
+		GetCollection(gomock.Any(), "sermon").
+		Return(&entities.VerseCollection{ID: "sermon", Name: "Sermón del domingo", Items: items}, nil)
+}
+
+// expectPassages answers every range of the version with verses whose text
+// is their number, like bibleActionFixture.expectPassages.
+func (a *collectionActionFixture) expectPassages(version int, times int) {
+	a.bible.EXPECT().
+		GetPassage(gomock.Any(), version, gomock.Any()).
+		Times(times).
+		DoAndReturn(func(_ context.Context, _ int, reference entities.ReferenceRange) ([]entities.PassageChapter, error) {
+			verses := []entities.Verse{}
+			for index := reference.StartVerse; index <= reference.EndVerse; index++ {
+				verses = append(verses, entities.Verse{Index: index})
+			}
+			return []entities.PassageChapter{{Book: reference.Book, Chapter: reference.StartChapter, Verses: verses}}, nil
+		})
+}
@@ -0,0 +1,206 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"strings"
+	"sync"
+	"time"
+)
+
+type LiveHistoryActionInterface interface {
+	RecordMessage(ctx context.Context, message []byte) error
+	CloseOpenEntries(ctx context.Context) error
+	ListLiveHistory(ctx context.Context, day time.Time) ([]entities.LiveHistoryEntry, error)
+	ListLiveHistoryDays(ctx context.Context) ([]entities.LiveHistoryDay, error)
+}
+
+// LiveHistoryAction follows the live websocket messages and writes to the
+// history every scene that goes on screen while the output is live.
+type LiveHistoryAction struct {
+	repo infrastructure.LiveHistoryRepository
+	now  func() time.Time
+
+	mu    sync.Mutex
+	live  bool
+	scene *liveSceneSummary
+	open  *openLiveEntry
+}
+
+// liveSceneSummary is what the history keeps of a scene. Key tells whether
+// two scenes are the same item, like two slides of one song.
+type liveSceneSummary struct {
+	key   string
+	entry entities.LiveHistoryEntry
+}
+
+type openLiveEntry struct {
+	id      int64
+	key     string
+	started time.Time
+	slides  int
+}
+
+func NewLiveHistoryAction(repo infrastructure.LiveHistoryRepository) LiveHistoryActionInterface {
+	return &LiveHistoryAction{repo: repo, now: time.Now}
+}
+
+// liveMessage holds the fields of the websocket messages the history reads.
+// The legacy verseUpdate, lyricsUpdate and coverUpdate messages carry their
+// payload at the top level.
+type liveMessage struct {
+	Type   string `json:"type"`
+	Status *struct {
+		Mode string `json:"mode"`
+	} `json:"status"`
+	Scene *liveScene `json:"scene"`
+	liveScenePayload
+}
+
+type liveScene struct {
+	Type    string           `json:"type"`
+	Payload liveScenePayload `json:"payload"`
+	Meta    struct {
+		Title        string `json:"title"`
+		SourceModule string `json:"sourceModule"`
+	} `json:"meta"`
+}
+
+type liveScenePayload struct {
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	SongID    string `json:"songId"`
+	Src       string `json:"src"`
+	Color     string `json:"color"`
+}
+
+// RecordMessage updates the history with one websocket message. A scene is
+// recorded when it is shown while the live status is "live"; it ends when
+// another scene replaces it, the scene is cleared or the output leaves live
+// mode. Legacy update messages come from clients without a live status and
+// are recorded as shown.
+func (a *LiveHistoryAction) RecordMessage(ctx context.Context, message []byte) error {
+	var msg liveMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+
+	switch msg.Type {
+	case "liveStatus":
+		if msg.Status == nil {
+			return nil
+		}
+		a.live = msg.Status.Mode == "live"
+		if !a.live || a.scene == nil {
+			return a.finish(ctx, now)
+		}
+		return a.show(ctx, *a.scene, now)
+	case "sceneUpdate":
+		if msg.Scene == nil {
+			a.scene = nil
+			return a.finish(ctx, now)
+		}
+		scene := summarizeScene(*msg.Scene)
+		a.scene = &scene
+		if !a.live {
+			return nil
+		}
+		return a.show(ctx, scene, now)
+	case "verseUpdate", "lyricsUpdate", "coverUpdate":
+		scene := summarizeScene(liveScene{Type: strings.TrimSuffix(msg.Type, "Update"), Payload: msg.liveScenePayload})
+		return a.show(ctx, scene, now)
+	}
+	return nil
+}
+
+func (a *LiveHistoryAction) show(ctx context.Context, scene liveSceneSummary, now time.Time) error {
+	if a.open != nil && a.open.key == scene.key {
+		a.open.slides++
+		return nil
+	}
+	if err := a.finish(ctx, now); err != nil {
+		return err
+	}
+
+	entry := scene.entry
+	entry.StartedAt = now.UTC().Format(time.RFC3339)
+	entry.Slides = 1
+	id, err := a.repo.StartLiveEntry(ctx, entry)
+	if err != nil {
+		return err
+	}
+	a.open = &openLiveEntry{id: id, key: scene.key, started: now, slides: 1}
+	return nil
+}
+
+func (a *LiveHistoryAction) finish(ctx context.Context, now time.Time) error {
+	if a.open == nil {
+		return nil
+	}
+	open := a.open
+	a.open = nil
+	duration := int(now.Sub(open.started).Round(time.Second) / time.Second)
+	return a.repo.FinishLiveEntry(ctx, open.id, now.UTC().Format(time.RFC3339), duration, open.slides)
+}
+
+func summarizeScene(scene liveScene) liveSceneSummary {
+	payload := scene.Payload
+	entry := entities.LiveHistoryEntry{
+		Type:   scene.Type,
+		Title:  scene.Meta.Title,
+		Source: scene.Meta.SourceModule,
+	}
+
+	key := scene.Type + "|"
+	switch scene.Type {
+	case "verse":
+		entry.Reference = payload.Reference
+		if entry.Title == "" {
+			entry.Title = payload.Reference
+		}
+		key += payload.Reference
+	case "lyrics":
+		entry.SongID = payload.SongID
+		entry.Title = payload.Title
+		if payload.SongID != "" {
+			key += payload.SongID
+		} else {
+			key += payload.Title
+		}
+	case "media":
+		entry.Reference = payload.Src
+		if entry.Reference == "" {
+			entry.Reference = payload.Color
+		}
+		key += entry.Reference
+	default:
+		if entry.Title == "" {
+			entry.Title = payload.Title
+		}
+		key += entry.Title
+	}
+	return liveSceneSummary{key: key, entry: entry}
+}
+
+// CloseOpenEntries ends the entries left open when the app stopped while a
+// scene was live, so they do not read as still on screen.
+func (a *LiveHistoryAction) CloseOpenEntries(ctx context.Context) error {
+	return a.repo.CloseOpenLiveEntries(ctx)
+}
+
+// ListLiveHistory returns what went live on one local calendar day.
+func (a *LiveHistoryAction) ListLiveHistory(ctx context.Context, day time.Time) ([]entities.LiveHistoryEntry, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	return a.repo.ListLiveHistory(ctx, start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+}
+
+func (a *LiveHistoryAction) ListLiveHistoryDays(ctx context.Context) ([]entities.LiveHistoryDay, error) {
+	return a.repo.ListLiveHistoryDays(ctx)
+}
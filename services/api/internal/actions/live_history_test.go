@@ -0,0 +1,161 @@
+package actions_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/infrastructure/mocks"
+	"testing"
+)
+
+const (
+	goLive     = `{"type":"liveStatus","status":{"mode":"live"}}`
+	leaveLive  = `{"type":"liveStatus","status":{"mode":"preview"}}`
+	clearScene = `{"type":"sceneUpdate","scene":null}`
+)
+
+func TestLiveHistoryAction_RecordMessage(t *testing.T) {
+	t.Run("should not record scenes shown before the output goes live", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+
+		f.record(sceneUpdate("verse", `{"reference":"Juan 3:16"}`, ""))
+	})
+
+	t.Run("should record the current scene when the output goes live", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+		f.expectStart(entities.LiveHistoryEntry{Type: "verse", Reference: "Juan 3:16", Title: "Juan 3:16", Source: sceneSource, Slides: 1}, 7)
+		f.expectFinish(7, 1)
+
+		f.record(sceneUpdate("verse", `{"reference":"Juan 3:16"}`, ""))
+		f.record(goLive)
+		f.record(leaveLive)
+	})
+
+	t.Run("should count the slides of one song as one entry", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+		f.expectStart(entities.LiveHistoryEntry{Type: "lyrics", SongID: "s1", Title: "Sublime gracia", Source: sceneSource, Slides: 1}, 3)
+		f.expectFinish(3, 3)
+
+		f.record(goLive)
+		f.record(sceneUpdate("lyrics", `{"songId":"s1","title":"Sublime gracia","content":"Sublime gracia"}`, "Verso 1"))
+		f.record(sceneUpdate("lyrics", `{"songId":"s1","title":"Sublime gracia","content":"Del Señor"}`, "Coro"))
+		f.record(sceneUpdate("lyrics", `{"songId":"s1","title":"Sublime gracia","content":"Que a un pecador"}`, "Verso 2"))
+		f.record(clearScene)
+	})
+
+	t.Run("should end an entry when another item replaces it", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+		gomock.InOrder(
+			f.expectStart(entities.LiveHistoryEntry{Type: "verse", Reference: "Salmos 23:1", Title: "Salmos 23:1", Source: sceneSource, Slides: 1}, 1),
+			f.expectFinish(1, 1),
+			f.expectStart(entities.LiveHistoryEntry{Type: "verse", Reference: "Salmos 23:2", Title: "Salmos 23:2", Source: sceneSource, Slides: 1}, 2),
+		)
+
+		f.record(goLive)
+		f.record(sceneUpdate("verse", `{"reference":"Salmos 23:1"}`, ""))
+		f.record(sceneUpdate("verse", `{"reference":"Salmos 23:2"}`, ""))
+	})
+
+	t.Run("should record legacy updates as shown", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+		f.expectStart(entities.LiveHistoryEntry{Type: "verse", Reference: "Romanos 8:28", Title: "Romanos 8:28", Slides: 1}, 5)
+
+		f.record(`{"type":"verseUpdate","reference":"Romanos 8:28","text":"Y sabemos"}`)
+	})
+
+	t.Run("should ignore messages it cannot read", func(t *testing.T) {
+		f := setupLiveHistoryFixture(t)
+
+		f.record(`not json`)
+		f.record(`{"type":"clientCount","count":2}`)
+		f.record(`{"type":"liveStatus"}`)
+	})
+}
+
+func TestLiveHistoryAction_RecordMessage_Summary(t *testing.T) {
+	tests := []struct {
+		name     string
+		scene    string
+		expected entities.LiveHistoryEntry
+	}{
+		{
+			name:     "verse keeps the meta title over the reference",
+			scene:    sceneUpdate("verse", `{"reference":"Juan 3:16"}`, "Versículo del día"),
+			expected: entities.LiveHistoryEntry{Type: "verse", Reference: "Juan 3:16", Title: "Versículo del día"},
+		},
+		{
+			name:     "lyrics take the song title",
+			scene:    sceneUpdate("lyrics", `{"songId":"s9","title":"Cuán grande es Él"}`, "Coro"),
+			expected: entities.LiveHistoryEntry{Type: "lyrics", SongID: "s9", Title: "Cuán grande es Él"},
+		},
+		{
+			name:     "media without a source records its color",
+			scene:    sceneUpdate("media", `{"color":"#000000"}`, "Negro"),
+			expected: entities.LiveHistoryEntry{Type: "media", Reference: "#000000", Title: "Negro"},
+		},
+		{
+			name:     "media records its source",
+			scene:    sceneUpdate("media", `{"src":"/uploads/images/fondo.png"}`, ""),
+			expected: entities.LiveHistoryEntry{Type: "media", Reference: "/uploads/images/fondo.png"},
+		},
+		{
+			name:     "other scenes fall back to the payload title",
+			scene:    sceneUpdate("cover", `{"title":"Bienvenidos"}`, ""),
+			expected: entities.LiveHistoryEntry{Type: "cover", Title: "Bienvenidos"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupLiveHistoryFixture(t)
+			tt.expected.Source = sceneSource
+			tt.expected.Slides = 1
+			f.expectStart(tt.expected, 1)
+
+			f.record(goLive)
+			f.record(tt.scene)
+		})
+	}
+}
+
+type liveHistoryFixture struct {
+	t      *testing.T
+	repo   *mocks.MockLiveHistoryRepository
+	action actions.LiveHistoryActionInterface
+}
+
+func setupLiveHistoryFixture(t *testing.T) *liveHistoryFixture {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockLiveHistoryRepository(ctrl)
+	return &liveHistoryFixture{t: t, repo: repo, action: actions.NewLiveHistoryAction(repo)}
+}
+
+func (a *liveHistoryFixture) record(message string) {
+	a.t.Helper()
+	require.NoError(a.t, a.action.RecordMessage(context.Background(), []byte(message)))
+}
+
+// expectStart checks the entry a scene starts, but for the time it started.
+func (a *liveHistoryFixture) expectStart(expected entities.LiveHistoryEntry, id int64) *gomock.Call {
+	return a.repo.EXPECT().StartLiveEntry(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry entities.LiveHistoryEntry) (int64, error) {
+			assert.NotEmpty(a.t, entry.StartedAt)
+			entry.StartedAt = ""
+			assert.Equal(a.t, expected, entry)
+			return id, nil
+		})
+}
+
+func (a *liveHistoryFixture) expectFinish(id int64, slides int) *gomock.Call {
+	return a.repo.EXPECT().FinishLiveEntry(gomock.Any(), id, gomock.Any(), gomock.Any(), slides).Return(nil)
+}
+
+// sceneSource is the module every sceneUpdate of these tests comes from.
+const sceneSource = "stage"
+
+func sceneUpdate(sceneType string, payload string, title string) string {
+	return `{"type":"sceneUpdate","scene":{"type":"` + sceneType + `","payload":` + payload +
+		`,"meta":{"title":"` + title + `","sourceModule":"` + sceneSource + `"}}}`
+}
@@ -2,15 +2,42 @@ package actions
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"services/api/domain/entities"
 	"services/api/internal/infrastructure"
+	"services/api/internal/songs"
+	"strings"
+	"time"
 )
 
+// Statuses of a song in an import report.
+const (
+	LyricsImported = "imported"
+	LyricsMerged   = "merged"
+	LyricsSkipped  = "skipped"
+)
+
+// defaultLyricsSettings are the LyricsStudio defaults, given to imported
+// songs that bring no settings of their own.
+var defaultLyricsSettings = entities.LyricsSettings{
+	FontFamily:     "Arial",
+	FontSize:       46,
+	TextColor:      "#ffffff",
+	Background:     "#000000",
+	Align:          "center",
+	HighlightColor: "#22c55e",
+}
+
 type LyricsActionInterface interface {
-	ListSongs(ctx context.Context) ([]entities.LyricsSongSummary, error)
+	ListSongs(ctx context.Context, filter entities.RequestLyricsSongs) ([]entities.LyricsSongSummary, error)
+	ListTags(ctx context.Context) ([]entities.LyricsTag, error)
 	GetSong(ctx context.Context, id string) (*entities.LyricsSong, error)
+	GetSlides(ctx context.Context, id string, arrangement string) (*entities.LyricsSlides, error)
 	UpsertSong(ctx context.Context, payload entities.LyricsSongPayload) (*entities.LyricsSong, error)
 	DeleteSong(ctx context.Context, id string) error
+	ImportSongs(ctx context.Context, files []entities.LyricsImportFile, skipExisting bool) (*entities.LyricsImportReport, error)
+	ExportSongs(ctx context.Context, ids []string) ([]entities.LyricsSong, error)
 }
 
 type LyricsAction struct {
@@ -21,18 +48,302 @@ func NewLyricsAction(repo infrastructure.LyricsRepository) LyricsActionInterface
 	return &LyricsAction{repo: repo}
 }
 
-func (a *LyricsAction) ListSongs(ctx context.Context) ([]entities.LyricsSongSummary, error) {
-	return a.repo.ListSongs(ctx)
+// ListSongs returns the library filtered and sorted as filter says. An
+// unknown key is reported as songs.ErrInvalidKey.
+func (a *LyricsAction) ListSongs(ctx context.Context, filter entities.RequestLyricsSongs) ([]entities.LyricsSongSummary, error) {
+	key, err := songs.NormalizeKey(filter.Key)
+	if err != nil {
+		return nil, err
+	}
+	filter.Key = key
+	return a.repo.ListSongs(ctx, filter)
 }
 
+func (a *LyricsAction) ListTags(ctx context.Context) ([]entities.LyricsTag, error) {
+	return a.repo.ListTags(ctx)
+}
+
+// GetSong returns a song with the credits line of its last slide.
 func (a *LyricsAction) GetSong(ctx context.Context, id string) (*entities.LyricsSong, error) {
-	return a.repo.GetSong(ctx, id)
+	song, err := a.repo.GetSong(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	song.Credits = songs.CreditLine(*song)
+	return song, nil
+}
+
+// GetSlides returns the slides of a song in the order of an arrangement,
+// its default one when arrangement is empty. An arrangement the song does
+// not have is reported as songs.ErrUnknownArrangement.
+func (a *LyricsAction) GetSlides(ctx context.Context, id string, arrangement string) (*entities.LyricsSlides, error) {
+	song, err := a.repo.GetSong(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	slides, chosen, err := songs.Arrange(*song, arrangement)
+	if err != nil {
+		return nil, err
+	}
+	result := &entities.LyricsSlides{SongID: song.ID, Slides: slides, Credits: songs.CreditLine(*song)}
+	if chosen != nil {
+		result.Arrangement, result.Name = chosen.ID, chosen.Name
+	}
+	return result, nil
 }
 
+// UpsertSong saves a song. Its key is written the way chords are ("Bb",
+// "F#m"); one that is not a key is reported as songs.ErrInvalidKey. The
+// arrangements are kept in step with the segments.
 func (a *LyricsAction) UpsertSong(ctx context.Context, payload entities.LyricsSongPayload) (*entities.LyricsSong, error) {
-	return a.repo.UpsertSong(ctx, payload)
+	key, err := songs.NormalizeKey(payload.Key)
+	if err != nil {
+		return nil, err
+	}
+	payload.Key = key
+	payload.Segments = syncChords(payload.Segments)
+	songs.SyncArrangements(&payload)
+	song, err := a.repo.UpsertSong(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	song.Credits = songs.CreditLine(*song)
+	return song, nil
+}
+
+// syncChords keeps the chords of every segment in step with its text. A
+// segment sent with chords only gets its text from them, and the chords of
+// an edited text are moved onto it with songs.AlignChords.
+func syncChords(segments []entities.LyricsSegment) []entities.LyricsSegment {
+	for i, segment := range segments {
+		if segment.Chords == "" {
+			continue
+		}
+		if strings.TrimSpace(segment.Content) == "" {
+			segments[i].Content = songs.StripChords(segment.Chords)
+		} else if songs.StripChords(segment.Content) != songs.StripChords(segment.Chords) {
+			segments[i].Chords = songs.AlignChords(segment.Chords, segment.Content)
+		}
+		if !songs.HasChords(segments[i].Chords) {
+			segments[i].Chords = ""
+		}
+	}
+	return segments
 }
 
 func (a *LyricsAction) DeleteSong(ctx context.Context, id string) error {
 	return a.repo.DeleteSong(ctx, id)
 }
+
+// ImportSongs stores the songs read from an import file. A song that is
+// already in the library, by CCLI number or else by title, is merged: the
+// file replaces its lyrics and segments and fills in its credits, while
+// its id, settings and other arrangements stay. With skipExisting those
+// songs are left alone. The title only matches when one of the two songs
+// has no CCLI number; two numbers that differ are two songs. Unreadable
+// files and songs identical to the stored ones are skipped.
+func (a *LyricsAction) ImportSongs(ctx context.Context, files []entities.LyricsImportFile, skipExisting bool) (*entities.LyricsImportReport, error) {
+	library, err := a.repo.ListSongs(ctx, entities.RequestLyricsSongs{})
+	if err != nil {
+		return nil, err
+	}
+	byCCLI := map[string]string{}
+	byTitle := map[string]entities.LyricsSongSummary{}
+	for _, song := range library {
+		if song.CCLI != "" {
+			byCCLI[song.CCLI] = song.ID
+		}
+		byTitle[songs.TitleKey(song.Title)] = song
+	}
+
+	report := &entities.LyricsImportReport{Songs: []entities.LyricsImportItem{}}
+	for _, file := range files {
+		item := entities.LyricsImportItem{File: file.File}
+		if file.Song == nil {
+			item.Status, item.Reason = LyricsSkipped, file.Error
+			addImportItem(report, item)
+			continue
+		}
+		payload := *file.Song
+		item.Title = payload.Title
+
+		existingID := byCCLI[payload.CCLI]
+		if existingID == "" {
+			match, ok := byTitle[songs.TitleKey(payload.Title)]
+			if ok && (match.CCLI == "" || payload.CCLI == "") {
+				existingID = match.ID
+			}
+		}
+
+		if existingID == "" {
+			payload.ID = fmt.Sprintf("lyr-%d", time.Now().UnixNano())
+			payload.Settings = defaultLyricsSettings
+			songs.SyncArrangements(&payload)
+			item.Status = LyricsImported
+		} else {
+			if skipExisting {
+				item.ID, item.Status, item.Reason = existingID, LyricsSkipped, "already in the library"
+				addImportItem(report, item)
+				continue
+			}
+			existing, err := a.repo.GetSong(ctx, existingID)
+			if err != nil {
+				return nil, err
+			}
+			payload = mergeSong(*existing, payload)
+			songs.SyncArrangements(&payload)
+			if sameSong(*existing, payload) {
+				item.ID, item.Status, item.Reason = existingID, LyricsSkipped, "unchanged"
+				addImportItem(report, item)
+				continue
+			}
+			item.Status = LyricsMerged
+		}
+
+		saved, err := a.repo.UpsertSong(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+		item.ID = saved.ID
+		if saved.CCLI != "" {
+			byCCLI[saved.CCLI] = saved.ID
+		}
+		byTitle[songs.TitleKey(saved.Title)] = entities.LyricsSongSummary{ID: saved.ID, CCLI: saved.CCLI}
+		addImportItem(report, item)
+	}
+	return report, nil
+}
+
+func addImportItem(report *entities.LyricsImportReport, item entities.LyricsImportItem) {
+	switch item.Status {
+	case LyricsImported:
+		report.Imported++
+	case LyricsMerged:
+		report.Merged++
+	default:
+		report.Skipped++
+	}
+	report.Songs = append(report.Songs, item)
+}
+
+// mergeSong applies an imported song onto a stored one. Credits missing in
+// the file keep their stored value, and the stored arrangements stay next
+// to the one read from the file, pointing at the imported segments that
+// took the place of theirs.
+func mergeSong(existing entities.LyricsSong, imported entities.LyricsSongPayload) entities.LyricsSongPayload {
+	merged := entities.LyricsSongPayload{
+		ID:                 existing.ID,
+		Title:              existing.Title,
+		Lyrics:             imported.Lyrics,
+		Segments:           imported.Segments,
+		Arrangements:       imported.Arrangements,
+		DefaultArrangement: imported.DefaultArrangement,
+		Settings:           existing.Settings,
+		Authors:            imported.Authors,
+		Copyright:          imported.Copyright,
+		CCLI:               imported.CCLI,
+		Key:                imported.Key,
+		Tempo:              imported.Tempo,
+		Tags:               imported.Tags,
+	}
+	ids := importedSegmentIDs(existing.Segments, imported.Segments)
+	for _, arrangement := range existing.Arrangements {
+		if arrangement.ID == merged.DefaultArrangement {
+			continue
+		}
+		sequence := make([]string, 0, len(arrangement.Sequence))
+		for _, id := range arrangement.Sequence {
+			if importedID, ok := ids[id]; ok {
+				sequence = append(sequence, importedID)
+			}
+		}
+		arrangement.Sequence = sequence
+		merged.Arrangements = append(merged.Arrangements, arrangement)
+	}
+	if merged.DefaultArrangement == "" {
+		merged.DefaultArrangement = existing.DefaultArrangement
+	}
+	if len(merged.Authors) == 0 {
+		merged.Authors = existing.Authors
+	}
+	if merged.Copyright == "" {
+		merged.Copyright = existing.Copyright
+	}
+	if merged.CCLI == "" {
+		merged.CCLI = existing.CCLI
+	}
+	if merged.Key == "" {
+		merged.Key = existing.Key
+	}
+	if merged.Tempo == 0 {
+		merged.Tempo = existing.Tempo
+	}
+	if len(merged.Tags) == 0 {
+		merged.Tags = existing.Tags
+	}
+	return merged
+}
+
+// importedSegmentIDs maps the ids of stored segments onto the imported
+// segments of the same kind and text, or else of the same kind and title.
+// A file numbers its segments anew, so the ids alone do not tell them apart.
+func importedSegmentIDs(stored []entities.LyricsSegment, imported []entities.LyricsSegment) map[string]string {
+	byText := map[string]string{}
+	byTitle := map[string]string{}
+	for _, segment := range imported {
+		textKey := segment.Kind + "\x00" + strings.TrimSpace(segment.Content)
+		if _, ok := byText[textKey]; !ok {
+			byText[textKey] = segment.ID
+		}
+		titleKey := segment.Kind + "\x00" + segment.Title
+		if _, ok := byTitle[titleKey]; !ok {
+			byTitle[titleKey] = segment.ID
+		}
+	}
+
+	ids := make(map[string]string, len(stored))
+	for _, segment := range stored {
+		if id, ok := byText[segment.Kind+"\x00"+strings.TrimSpace(segment.Content)]; ok {
+			ids[segment.ID] = id
+		} else if id, ok := byTitle[segment.Kind+"\x00"+segment.Title]; ok {
+			ids[segment.ID] = id
+		}
+	}
+	return ids
+}
+
+func sameSong(existing entities.LyricsSong, payload entities.LyricsSongPayload) bool {
+	return existing.Lyrics == payload.Lyrics &&
+		reflect.DeepEqual(existing.Segments, payload.Segments) &&
+		reflect.DeepEqual(existing.Arrangements, payload.Arrangements) &&
+		existing.DefaultArrangement == payload.DefaultArrangement &&
+		reflect.DeepEqual(existing.Authors, payload.Authors) &&
+		existing.Copyright == payload.Copyright &&
+		existing.CCLI == payload.CCLI &&
+		existing.Key == payload.Key &&
+		existing.Tempo == payload.Tempo &&
+		reflect.DeepEqual(existing.Tags, payload.Tags)
+}
+
+// ExportSongs returns the songs with the given ids, or the whole library
+// when ids is empty. A missing id is reported as sql.ErrNoRows.
+func (a *LyricsAction) ExportSongs(ctx context.Context, ids []string) ([]entities.LyricsSong, error) {
+	if len(ids) == 0 {
+		library, err := a.repo.ListSongs(ctx, entities.RequestLyricsSongs{})
+		if err != nil {
+			return nil, err
+		}
+		for _, song := range library {
+			ids = append(ids, song.ID)
+		}
+	}
+	exported := make([]entities.LyricsSong, 0, len(ids))
+	for _, id := range ids {
+		song, err := a.repo.GetSong(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		exported = append(exported, *song)
+	}
+	return exported, nil
+}
@@ -0,0 +1,275 @@
+package actions_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/infrastructure/mocks"
+	"services/api/internal/songs"
+	"strings"
+	"testing"
+)
+
+func TestLyricsAction_ImportSongs(t *testing.T) {
+	t.Run("should import a song the library does not have", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary()
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.True(t, strings.HasPrefix(payload.ID, "lyr-"))
+			assert.Equal(t, "Sublime gracia", payload.Title)
+			assert.NotEmpty(t, payload.Settings.FontFamily)
+		})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{importFile("sublime.xml", "Sublime gracia", "22025", "Sublime gracia del Señor")}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Imported)
+		assert.Equal(t, actions.LyricsImported, report.Songs[0].Status)
+	})
+
+	t.Run("should merge a song with the same CCLI number under another title", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia", CCLI: "22025"})
+		f.expectStored(storedSong("s1", "Sublime gracia", "22025", "Sublime gracia del Señor"))
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.Equal(t, "s1", payload.ID)
+			assert.Equal(t, "Sublime gracia", payload.Title)
+			assert.Equal(t, "Que a un pecador salvó", payload.Segments[0].Content)
+		})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{importFile("amazing.xml", "Amazing Grace", "22025", "Que a un pecador salvó")}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Merged)
+		assert.Equal(t, "s1", report.Songs[0].ID)
+	})
+
+	t.Run("should merge by title when one of the songs has no CCLI number", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia"})
+		f.expectStored(storedSong("s1", "Sublime gracia", "", "Sublime gracia del Señor"))
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.Equal(t, "s1", payload.ID)
+			assert.Equal(t, "22025", payload.CCLI)
+		})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{importFile("sublime.xml", "Sublime Gracia", "22025", "Que a un pecador salvó")}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Merged)
+	})
+
+	t.Run("should import a song whose CCLI number differs from the one with its title", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Santo", CCLI: "1111"})
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.NotEqual(t, "s1", payload.ID)
+			assert.Equal(t, "2222", payload.CCLI)
+		})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{importFile("santo.xml", "Santo", "2222", "Santo, santo, santo")}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Imported)
+		assert.Zero(t, report.Merged)
+	})
+
+	t.Run("should keep the stored arrangements on the segments the file numbers anew", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		stored := storedSong("s1", "Sublime gracia", "22025", "Sublime gracia del Señor")
+		stored.Segments = []entities.LyricsSegment{
+			{ID: "seg-1", Title: "Verso 1", Content: "Sublime gracia del Señor", Kind: "verse"},
+			{ID: "seg-2", Title: "Coro", Content: "Gloria a Dios", Kind: "chorus"},
+			{ID: "seg-3", Title: "Final", Content: "Amén", Kind: "verse"},
+		}
+		stored.Arrangements = []entities.LyricsArrangement{{ID: "a7", Name: "Ensayo", Sequence: []string{"seg-1", "seg-2", "seg-2", "seg-3"}}}
+		stored.DefaultArrangement = "a7"
+		imported := importFile("sublime.xml", "Sublime gracia", "22025", "Sublime gracia del Señor")
+		imported.Song.Segments = append(imported.Song.Segments, entities.LyricsSegment{ID: "c1", Title: "Coro", Content: "¡Gloria a Dios!", Kind: "chorus"})
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia", CCLI: "22025"})
+		f.expectStored(stored)
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			require.Len(t, payload.Arrangements, 1)
+			assert.Equal(t, []string{"v1", "c1", "c1"}, payload.Arrangements[0].Sequence)
+			assert.Equal(t, "a7", payload.DefaultArrangement)
+		})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{imported}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Merged)
+	})
+
+	t.Run("should put the arrangement of the file first and as the default", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		stored := storedSong("s1", "Sublime gracia", "22025", "Sublime gracia del Señor")
+		stored.Segments[0].ID = "seg-1"
+		stored.Arrangements = []entities.LyricsArrangement{{ID: "a2", Name: "Ensayo", Sequence: []string{"seg-1"}}}
+		stored.DefaultArrangement = "a2"
+		imported := importFile("sublime.xml", "Sublime gracia", "22025", "Sublime gracia del Señor")
+		imported.Song.Arrangements = []entities.LyricsArrangement{{ID: "a1", Name: "Original", Sequence: []string{"v1", "v1"}}}
+		imported.Song.DefaultArrangement = "a1"
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia", CCLI: "22025"})
+		f.expectStored(stored)
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.Equal(t, []entities.LyricsArrangement{
+				{ID: "a1", Name: "Original", Sequence: []string{"v1", "v1"}},
+				{ID: "a2", Name: "Ensayo", Sequence: []string{"v1"}},
+			}, payload.Arrangements)
+			assert.Equal(t, "a1", payload.DefaultArrangement)
+		})
+
+		_, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{imported}, false)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should skip songs in the library and unreadable files", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia", CCLI: "22025"})
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{
+			importFile("sublime.xml", "Sublime gracia", "22025", "Que a un pecador salvó"),
+			{File: "roto.xml", Error: "invalid xml"},
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Skipped)
+		assert.Equal(t, "already in the library", report.Songs[0].Reason)
+		assert.Equal(t, "invalid xml", report.Songs[1].Reason)
+	})
+
+	t.Run("should skip a song identical to the stored one", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectLibrary(entities.LyricsSongSummary{ID: "s1", Title: "Sublime gracia", CCLI: "22025"})
+		f.expectStored(storedSong("s1", "Sublime gracia", "22025", "Sublime gracia del Señor"))
+
+		report, err := f.action.ImportSongs(context.Background(), []entities.LyricsImportFile{importFile("sublime.xml", "Sublime gracia", "22025", "Sublime gracia del Señor")}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Skipped)
+		assert.Equal(t, "unchanged", report.Songs[0].Reason)
+	})
+}
+
+func TestLyricsAction_ListSongs(t *testing.T) {
+	t.Run("should pass the filters on with the key written as chords are", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		filter := entities.RequestLyricsSongs{Key: "bb", Tag: "adoración", TempoMin: 60, TempoMax: 90}
+		f.repo.EXPECT().
+			ListSongs(gomock.Any(), entities.RequestLyricsSongs{Key: "Bb", Tag: "adoración", TempoMin: 60, TempoMax: 90}).
+			Return([]entities.LyricsSongSummary{{ID: "s1", Title: "Cuán grande es Él"}}, nil)
+
+		found, err := f.action.ListSongs(context.Background(), filter)
+
+		require.NoError(t, err)
+		assert.Len(t, found, 1)
+	})
+
+	t.Run("should reject a key that is not a note", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+
+		_, err := f.action.ListSongs(context.Background(), entities.RequestLyricsSongs{Key: "H"})
+
+		assert.ErrorIs(t, err, songs.ErrInvalidKey)
+	})
+}
+
+func TestLyricsAction_UpsertSong(t *testing.T) {
+	t.Run("should move the chords onto an edited text", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.Equal(t, "[G]Sublime [C]gracia del [G]Señor\nque a mí, [D]pecador, salvó", payload.Segments[0].Chords)
+		})
+
+		_, err := f.action.UpsertSong(context.Background(), entities.LyricsSongPayload{Title: "Sublime gracia", Segments: []entities.LyricsSegment{{
+			ID:      "v1",
+			Content: "Sublime gracia del Señor\nque a mí, pecador, salvó",
+			Chords:  "[G]Sublime [C]gracia del [G]Señor\nque a un [D]pecador salvó",
+			Kind:    "verse",
+		}}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fill in the text of a segment sent with chords only", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+		f.expectSave(func(payload entities.LyricsSongPayload) {
+			assert.Equal(t, "Sublime gracia del Señor", payload.Segments[0].Content)
+			assert.Equal(t, "[Do]Sublime [Fa]gracia del [Do]Señor", payload.Segments[0].Chords)
+			assert.Equal(t, "Do", payload.Key)
+		})
+
+		_, err := f.action.UpsertSong(context.Background(), entities.LyricsSongPayload{Title: "Sublime gracia", Key: "do", Segments: []entities.LyricsSegment{{
+			ID:     "v1",
+			Chords: "[Do]Sublime [Fa]gracia del [Do]Señor",
+			Kind:   "verse",
+		}}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a key that is not a note", func(t *testing.T) {
+		f := setupLyricsActionFixture(t)
+
+		_, err := f.action.UpsertSong(context.Background(), entities.LyricsSongPayload{Title: "Santo", Key: "H"})
+
+		assert.ErrorIs(t, err, songs.ErrInvalidKey)
+	})
+}
+
+type lyricsActionFixture struct {
+	repo   *mocks.MockLyricsRepository
+	action actions.LyricsActionInterface
+}
+
+func setupLyricsActionFixture(t *testing.T) *lyricsActionFixture {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockLyricsRepository(ctrl)
+	return &lyricsActionFixture{repo: repo, action: actions.NewLyricsAction(repo)}
+}
+
+func (a *lyricsActionFixture) expectLibrary(library ...entities.LyricsSongSummary) {
+	a.repo.EXPECT().ListSongs(gomock.Any(), entities.RequestLyricsSongs{}).Return(library, nil)
+}
+
+func (a *lyricsActionFixture) expectStored(song entities.LyricsSong) {
+	a.repo.EXPECT().GetSong(gomock.Any(), song.ID).Return(&song, nil)
+}
+
+// expectSave checks the payload of one save and stores it as sent.
+func (a *lyricsActionFixture) expectSave(check func(payload entities.LyricsSongPayload)) {
+	a.repo.EXPECT().
+		UpsertSong(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, payload entities.LyricsSongPayload) (*entities.LyricsSong, error) {
+			check(payload)
+			return &entities.LyricsSong{ID: payload.ID, Title: payload.Title, CCLI: payload.CCLI}, nil
+		})
+}
+
+func importFile(file string, title string, ccli string, content string) entities.LyricsImportFile {
+	return entities.LyricsImportFile{File: file, Song: &entities.LyricsSongPayload{
+		Title:    title,
+		Lyrics:   content,
+		CCLI:     ccli,
+		Segments: []entities.LyricsSegment{{ID: "v1", Title: "Verso 1", Content: content, Kind: "verse"}},
+	}}
+}
+
+// storedSong is a song as the repository reads it back, with empty lists
+// rather than nil ones.
+func storedSong(id string, title string, ccli string, content string) entities.LyricsSong {
+	return entities.LyricsSong{
+		ID:           id,
+		Title:        title,
+		Lyrics:       content,
+		CCLI:         ccli,
+		Segments:     []entities.LyricsSegment{{ID: "v1", Title: "Verso 1", Content: content, Kind: "verse"}},
+		Arrangements: []entities.LyricsArrangement{},
+		Authors:      []string{},
+		Tags:         []string{},
+	}
+}
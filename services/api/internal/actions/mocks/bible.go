@@ -6,8 +6,9 @@ package mocks
 
 import (
 	context "context"
-	entities "services/api/domain/entities"
 	reflect "reflect"
+	entities "services/api/domain/entities"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -50,19 +51,184 @@ func (mr *MockBibleActionInterfaceMockRecorder) GetBibleReferences(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBibleReferences", reflect.TypeOf((*MockBibleActionInterface)(nil).GetBibleReferences), ctx, request)
 }
 
+// GetParallelPassage mocks base method.
+func (m *MockBibleActionInterface) GetParallelPassage(ctx context.Context, ref string, versions []int) (*entities.ParallelPassage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParallelPassage", ctx, ref, versions)
+	ret0, _ := ret[0].(*entities.ParallelPassage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParallelPassage indicates an expected call of GetParallelPassage.
+func (mr *MockBibleActionInterfaceMockRecorder) GetParallelPassage(ctx, ref, versions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParallelPassage", reflect.TypeOf((*MockBibleActionInterface)(nil).GetParallelPassage), ctx, ref, versions)
+}
+
+// GetPassage mocks base method.
+func (m *MockBibleActionInterface) GetPassage(ctx context.Context, ref string, version int) (*entities.Passage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassage", ctx, ref, version)
+	ret0, _ := ret[0].(*entities.Passage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassage indicates an expected call of GetPassage.
+func (mr *MockBibleActionInterfaceMockRecorder) GetPassage(ctx, ref, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassage", reflect.TypeOf((*MockBibleActionInterface)(nil).GetPassage), ctx, ref, version)
+}
+
+// GetPassageSlides mocks base method.
+func (m *MockBibleActionInterface) GetPassageSlides(ctx context.Context, request entities.RequestSlides) (*entities.PassageSlides, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassageSlides", ctx, request)
+	ret0, _ := ret[0].(*entities.PassageSlides)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassageSlides indicates an expected call of GetPassageSlides.
+func (mr *MockBibleActionInterfaceMockRecorder) GetPassageSlides(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassageSlides", reflect.TypeOf((*MockBibleActionInterface)(nil).GetPassageSlides), ctx, request)
+}
+
+// GetRelatedVerses mocks base method.
+func (m *MockBibleActionInterface) GetRelatedVerses(ctx context.Context, request entities.RequestRelated) (*entities.RelatedVerses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedVerses", ctx, request)
+	ret0, _ := ret[0].(*entities.RelatedVerses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedVerses indicates an expected call of GetRelatedVerses.
+func (mr *MockBibleActionInterfaceMockRecorder) GetRelatedVerses(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedVerses", reflect.TypeOf((*MockBibleActionInterface)(nil).GetRelatedVerses), ctx, request)
+}
+
+// GetVerseOfTheDay mocks base method.
+func (m *MockBibleActionInterface) GetVerseOfTheDay(ctx context.Context, date time.Time, version int) (*entities.VerseOfTheDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerseOfTheDay", ctx, date, version)
+	ret0, _ := ret[0].(*entities.VerseOfTheDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerseOfTheDay indicates an expected call of GetVerseOfTheDay.
+func (mr *MockBibleActionInterfaceMockRecorder) GetVerseOfTheDay(ctx, date, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerseOfTheDay", reflect.TypeOf((*MockBibleActionInterface)(nil).GetVerseOfTheDay), ctx, date, version)
+}
+
+// ImportBible mocks base method.
+func (m *MockBibleActionInterface) ImportBible(ctx context.Context, bible entities.BibleImport, options entities.BibleImportOptions) (*entities.BibleImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBible", ctx, bible, options)
+	ret0, _ := ret[0].(*entities.BibleImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBible indicates an expected call of ImportBible.
+func (mr *MockBibleActionInterfaceMockRecorder) ImportBible(ctx, bible, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBible", reflect.TypeOf((*MockBibleActionInterface)(nil).ImportBible), ctx, bible, options)
+}
+
+// ImportCrossReferences mocks base method.
+func (m *MockBibleActionInterface) ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCrossReferences", ctx, refs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCrossReferences indicates an expected call of ImportCrossReferences.
+func (mr *MockBibleActionInterfaceMockRecorder) ImportCrossReferences(ctx, refs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCrossReferences", reflect.TypeOf((*MockBibleActionInterface)(nil).ImportCrossReferences), ctx, refs)
+}
+
+// ListBibles mocks base method.
+func (m *MockBibleActionInterface) ListBibles(ctx context.Context) ([]entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBibles", ctx)
+	ret0, _ := ret[0].([]entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBibles indicates an expected call of ListBibles.
+func (mr *MockBibleActionInterfaceMockRecorder) ListBibles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBibles", reflect.TypeOf((*MockBibleActionInterface)(nil).ListBibles), ctx)
+}
+
+// ListBooks mocks base method.
+func (m *MockBibleActionInterface) ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBooks", ctx, version)
+	ret0, _ := ret[0].([]entities.BookInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBooks indicates an expected call of ListBooks.
+func (mr *MockBibleActionInterfaceMockRecorder) ListBooks(ctx, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBooks", reflect.TypeOf((*MockBibleActionInterface)(nil).ListBooks), ctx, version)
+}
+
+// ParseReference mocks base method.
+func (m *MockBibleActionInterface) ParseReference(ctx context.Context, ref string, version int) ([]entities.ReferenceRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseReference", ctx, ref, version)
+	ret0, _ := ret[0].([]entities.ReferenceRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseReference indicates an expected call of ParseReference.
+func (mr *MockBibleActionInterfaceMockRecorder) ParseReference(ctx, ref, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseReference", reflect.TypeOf((*MockBibleActionInterface)(nil).ParseReference), ctx, ref, version)
+}
+
 // SearchVerses mocks base method.
-func (m *MockBibleActionInterface) SearchVerses(ctx context.Context, terms []string, version int, limit int) ([]entities.VerseMatch, error) {
+func (m *MockBibleActionInterface) SearchVerses(ctx context.Context, query entities.SearchQuery, version, limit, offset int) (*entities.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchVerses", ctx, terms, version, limit)
-	ret0, _ := ret[0].([]entities.VerseMatch)
+	ret := m.ctrl.Call(m, "SearchVerses", ctx, query, version, limit, offset)
+	ret0, _ := ret[0].(*entities.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchVerses indicates an expected call of SearchVerses.
-func (mr *MockBibleActionInterfaceMockRecorder) SearchVerses(ctx, terms, version, limit interface{}) *gomock.Call {
+func (mr *MockBibleActionInterfaceMockRecorder) SearchVerses(ctx, query, version, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVerses", reflect.TypeOf((*MockBibleActionInterface)(nil).SearchVerses), ctx, query, version, limit, offset)
+}
+
+// UpdateBible mocks base method.
+func (m *MockBibleActionInterface) UpdateBible(ctx context.Context, metadata entities.BibleMetadata) (*entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBible", ctx, metadata)
+	ret0, _ := ret[0].(*entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBible indicates an expected call of UpdateBible.
+func (mr *MockBibleActionInterfaceMockRecorder) UpdateBible(ctx, metadata interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVerses", reflect.TypeOf((*MockBibleActionInterface)(nil).SearchVerses), ctx, terms, version, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBible", reflect.TypeOf((*MockBibleActionInterface)(nil).UpdateBible), ctx, metadata)
 }
 
 // VerifyBibleReference mocks base method.
@@ -0,0 +1,144 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/infrastructure"
+	"time"
+)
+
+// ErrPlanNotFound tells a missing plan apart from a missing bible.
+var ErrPlanNotFound = errors.New("plan not found")
+
+// PlanNotStartedError is returned for a date before the start of a plan.
+type PlanNotStartedError struct {
+	StartDate string
+}
+
+func (e *PlanNotStartedError) Error() string {
+	return fmt.Sprintf("plan starts on %s", e.StartDate)
+}
+
+type PlanActionInterface interface {
+	ListPlans(ctx context.Context) ([]entities.ReadingPlanSummary, error)
+	GetPlan(ctx context.Context, id string) (*entities.ReadingPlan, error)
+	ImportPlan(ctx context.Context, plan entities.ReadingPlanImport) (*entities.ReadingPlan, error)
+	DeletePlan(ctx context.Context, id string) error
+	GetPlanToday(ctx context.Context, id string, date time.Time, version int) (*entities.ReadingPlanToday, error)
+}
+
+type PlanAction struct {
+	repo  infrastructure.PlanRepository
+	bible BibleAction
+}
+
+func NewPlanAction(repo infrastructure.PlanRepository, bible infrastructure.DatabaseGetter) PlanActionInterface {
+	return &PlanAction{repo: repo, bible: BibleAction{Db: bible}}
+}
+
+func (a *PlanAction) ListPlans(ctx context.Context) ([]entities.ReadingPlanSummary, error) {
+	return a.repo.ListPlans(ctx)
+}
+
+func (a *PlanAction) GetPlan(ctx context.Context, id string) (*entities.ReadingPlan, error) {
+	plan, err := a.repo.GetPlan(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPlanNotFound
+	}
+	return plan, err
+}
+
+func (a *PlanAction) ImportPlan(ctx context.Context, plan entities.ReadingPlanImport) (*entities.ReadingPlan, error) {
+	return a.repo.ImportPlan(ctx, plan)
+}
+
+func (a *PlanAction) DeletePlan(ctx context.Context, id string) error {
+	return a.repo.DeletePlan(ctx, id)
+}
+
+// GetPlanToday returns the day of the plan that falls on date, with the text
+// of its readings in version. Past the last day the plan starts over, so a
+// yearly plan keeps going the next year.
+func (a *PlanAction) GetPlanToday(ctx context.Context, id string, date time.Time, version int) (*entities.ReadingPlanToday, error) {
+	plan, err := a.repo.GetPlan(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+	bible, err := a.bible.Db.GetBible(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	day, err := planDay(plan, date)
+	if err != nil {
+		return nil, err
+	}
+	readings, err := a.repo.GetPlanDay(ctx, plan.ID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	today := &entities.ReadingPlanToday{
+		PlanID:   plan.ID,
+		Name:     plan.Name,
+		Date:     date.Format("2006-01-02"),
+		Day:      day,
+		Days:     plan.Days,
+		Version:  bible.ID,
+		Readings: make([]entities.PlanReading, 0, len(readings)),
+	}
+	for _, reference := range readings {
+		reading := entities.PlanReading{Reference: reference}
+		passage, err := a.bible.passage(ctx, reference, bible.ID)
+		var refErr *bibleref.Error
+		switch {
+		case errors.As(err, &refErr):
+			reading.Error = refErr.Error()
+		case err != nil:
+			return nil, err
+		default:
+			reading.Passage = passage
+		}
+		today.Readings = append(today.Readings, reading)
+	}
+	return today, nil
+}
+
+// planDay numbers date inside the plan, counting calendar days from the
+// start date or, without one, from January 1st of the year of date.
+func planDay(plan *entities.ReadingPlan, date time.Time) (int, error) {
+	day := calendarDay(date, plan.Days)
+	if plan.StartDate != "" {
+		start, err := time.Parse("2006-01-02", plan.StartDate)
+		if err != nil {
+			return 0, fmt.Errorf("plan %s has an invalid start date %q", plan.ID, plan.StartDate)
+		}
+		current := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		day = int(current.Sub(start).Hours()/24) + 1
+		if day < 1 {
+			return 0, &PlanNotStartedError{StartDate: plan.StartDate}
+		}
+	}
+	if plan.Days <= 0 {
+		return day, nil
+	}
+	return (day-1)%plan.Days + 1, nil
+}
+
+// calendarDay numbers date from January 1st so that a plan shorter than a
+// leap year reads the same dates every year: February 29th repeats the day
+// of February 28th and December 31st is always day 365.
+func calendarDay(date time.Time, days int) int {
+	day := date.YearDay()
+	leapYear := time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366
+	if !leapYear || days >= 366 || day < 60 {
+		return day
+	}
+	return day - 1
+}
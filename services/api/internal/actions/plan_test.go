@@ -0,0 +1,109 @@
+package actions_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/infrastructure/mocks"
+	"testing"
+	"time"
+)
+
+func TestPlanAction_GetPlanToday(t *testing.T) {
+	yearly := entities.ReadingPlan{ID: "anual", Name: "La Biblia en un año", Days: 365}
+	tests := []struct {
+		name     string
+		plan     entities.ReadingPlan
+		date     time.Time
+		expected int
+	}{
+		{name: "January 1st is day 1", plan: yearly, date: day(2027, time.January, 1), expected: 1},
+		{name: "December 31st is day 365", plan: yearly, date: day(2027, time.December, 31), expected: 365},
+		{name: "February 29th repeats February 28th", plan: yearly, date: day(2028, time.February, 29), expected: 59},
+		{name: "March 1st of a leap year is day 60", plan: yearly, date: day(2028, time.March, 1), expected: 60},
+		{name: "December 31st of a leap year is day 365", plan: yearly, date: day(2028, time.December, 31), expected: 365},
+		{
+			name:     "a leap-year plan reads February 29th on its own day",
+			plan:     entities.ReadingPlan{ID: "bisiesto", Name: "Año bisiesto", Days: 366},
+			date:     day(2028, time.December, 31),
+			expected: 366,
+		},
+		{
+			name:     "a plan with a start date counts from it",
+			plan:     entities.ReadingPlan{ID: "evangelios", Name: "Evangelios", StartDate: "2028-02-27", Days: 89},
+			date:     day(2028, time.March, 1),
+			expected: 4,
+		},
+		{
+			name:     "a plan starts over after its last day",
+			plan:     entities.ReadingPlan{ID: "evangelios", Name: "Evangelios", StartDate: "2027-01-01", Days: 89},
+			date:     day(2027, time.March, 31),
+			expected: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run("should know "+tt.name, func(t *testing.T) {
+			f := setupPlanActionFixture(t)
+			plan := tt.plan
+			f.repo.EXPECT().GetPlan(gomock.Any(), plan.ID).Return(&plan, nil)
+			f.bible.EXPECT().GetBible(gomock.Any(), 1).Return(&entities.Bible{ID: 1, Name: "Reina Valera 1960"}, nil)
+			f.repo.EXPECT().GetPlanDay(gomock.Any(), plan.ID, tt.expected).Return([]string{}, nil)
+
+			today, err := f.action.GetPlanToday(context.Background(), plan.ID, tt.date, 1)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, today.Day)
+		})
+	}
+
+	t.Run("should refuse a date before the plan starts", func(t *testing.T) {
+		f := setupPlanActionFixture(t)
+		f.repo.EXPECT().GetPlan(gomock.Any(), "evangelios").Return(&entities.ReadingPlan{ID: "evangelios", StartDate: "2028-03-01", Days: 89}, nil)
+		f.bible.EXPECT().GetBible(gomock.Any(), 1).Return(&entities.Bible{ID: 1}, nil)
+
+		_, err := f.action.GetPlanToday(context.Background(), "evangelios", day(2028, time.February, 29), 1)
+
+		var notStarted *actions.PlanNotStartedError
+		require.ErrorAs(t, err, &notStarted)
+		assert.Equal(t, "2028-03-01", notStarted.StartDate)
+	})
+
+	t.Run("should keep a reading the version cannot show with its error", func(t *testing.T) {
+		f := setupPlanActionFixture(t)
+		f.repo.EXPECT().GetPlan(gomock.Any(), "anual").Return(&entities.ReadingPlan{ID: "anual", Days: 365}, nil)
+		f.bible.EXPECT().GetBible(gomock.Any(), 1).Return(&entities.Bible{ID: 1}, nil)
+		f.repo.EXPECT().GetPlanDay(gomock.Any(), "anual", 1).Return([]string{"Juan 22", "Juan 1:1"}, nil)
+		f.bible.EXPECT().GetChapterSizes(gomock.Any(), 1, "juan").Return(map[int]int{1: 51}, nil).Times(2)
+		f.bible.EXPECT().
+			GetPassage(gomock.Any(), 1, gomock.Any()).
+			Return([]entities.PassageChapter{{Book: "juan", Chapter: 1, Verses: []entities.Verse{{Index: 1}}}}, nil)
+
+		today, err := f.action.GetPlanToday(context.Background(), "anual", day(2027, time.January, 1), 1)
+
+		require.NoError(t, err)
+		require.Len(t, today.Readings, 2)
+		assert.NotEmpty(t, today.Readings[0].Error)
+		assert.Nil(t, today.Readings[0].Passage)
+		assert.NotNil(t, today.Readings[1].Passage)
+	})
+}
+
+type planActionFixture struct {
+	repo   *mocks.MockPlanRepository
+	bible  *mocks.MockDatabaseGetter
+	action actions.PlanActionInterface
+}
+
+func setupPlanActionFixture(t *testing.T) *planActionFixture {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockPlanRepository(ctrl)
+	bible := mocks.NewMockDatabaseGetter(ctrl)
+	return &planActionFixture{repo: repo, bible: bible, action: actions.NewPlanAction(repo, bible)}
+}
+
+func day(year int, month time.Month, dayOfMonth int) time.Time {
+	return time.Date(year, month, dayOfMonth, 8, 0, 0, 0, time.UTC)
+}
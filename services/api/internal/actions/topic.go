@@ -0,0 +1,130 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/infrastructure"
+)
+
+// ErrTopicNotFound tells a missing topic apart from a missing bible.
+var ErrTopicNotFound = errors.New("topic not found")
+
+const (
+	defaultTopics      = 20
+	maxTopics          = 100
+	defaultTopicVerses = 20
+	maxTopicVerses     = 100
+)
+
+type TopicActionInterface interface {
+	ImportTopics(ctx context.Context, topics []entities.TopicImport) (int, error)
+	SearchTopics(ctx context.Context, query string, limit int) ([]entities.Topic, error)
+	GetTopicVerses(ctx context.Context, request entities.RequestTopicVerses) (*entities.TopicVerses, error)
+}
+
+type TopicAction struct {
+	repo  infrastructure.TopicRepository
+	bible infrastructure.DatabaseGetter
+}
+
+func NewTopicAction(repo infrastructure.TopicRepository, bible infrastructure.DatabaseGetter) TopicActionInterface {
+	return &TopicAction{repo: repo, bible: bible}
+}
+
+// ImportTopics replaces the topical index.
+func (a *TopicAction) ImportTopics(ctx context.Context, topics []entities.TopicImport) (int, error) {
+	return a.repo.ImportTopics(ctx, topics)
+}
+
+func (a *TopicAction) SearchTopics(ctx context.Context, query string, limit int) ([]entities.Topic, error) {
+	if limit <= 0 {
+		limit = defaultTopics
+	}
+	return a.repo.SearchTopics(ctx, query, min(limit, maxTopics))
+}
+
+// GetTopicVerses returns one page of the references of a topic with their
+// text in request.Version. References are stored in the standard numbering
+// and go through the versification of the version, as cross references do.
+// A whole-chapter reference is filled in from the chapter sizes.
+func (a *TopicAction) GetTopicVerses(ctx context.Context, request entities.RequestTopicVerses) (*entities.TopicVerses, error) {
+	topic, err := a.repo.GetTopic(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTopicNotFound
+		}
+		return nil, err
+	}
+	bible, err := a.bible.GetBible(ctx, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := a.bible.GetVersificationMappings(ctx, bible.Versification)
+	if err != nil {
+		return nil, err
+	}
+	scheme := bibleref.Versification(mappings)
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultTopicVerses
+	}
+	limit = min(limit, maxTopicVerses)
+	offset := max(request.Offset, 0)
+
+	refs, err := a.repo.GetTopicReferences(ctx, topic.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.TopicVerses{
+		ID:       topic.ID,
+		Name:     topic.Name,
+		Total:    topic.References,
+		Offset:   offset,
+		Limit:    limit,
+		Passages: []entities.TopicPassage{},
+	}
+	if next := offset + len(refs); next < topic.References {
+		result.NextOffset = &next
+	}
+
+	sizes := map[string]map[int]int{}
+	for _, target := range refs {
+		if target.StartVerse == 0 || target.EndVerse == 0 {
+			if _, ok := sizes[target.Book]; !ok {
+				if sizes[target.Book], err = a.bible.GetChapterSizes(ctx, bible.ID, target.Book); err != nil {
+					return nil, err
+				}
+			}
+			if bibleref.Check(&target, sizes[target.Book]) != nil {
+				continue
+			}
+		} else {
+			target.StartChapter, target.StartVerse = scheme.FromStandard(target.Book, target.StartChapter, target.StartVerse)
+			target.EndChapter, target.EndVerse = scheme.FromStandard(target.Book, target.EndChapter, target.EndVerse)
+			target.Reference = bibleref.Format(target)
+		}
+
+		chapters, err := a.bible.GetPassage(ctx, bible.ID, target)
+		if err != nil {
+			return nil, err
+		}
+		verses := []entities.Verse{}
+		for _, chapter := range chapters {
+			verses = append(verses, chapter.Verses...)
+		}
+		if len(verses) == 0 {
+			continue
+		}
+		result.Passages = append(result.Passages, entities.TopicPassage{
+			Reference: target.Reference,
+			Range:     target,
+			Verses:    verses,
+		})
+	}
+	return result, nil
+}
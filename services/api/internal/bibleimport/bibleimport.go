@@ -0,0 +1,171 @@
+package bibleimport
+
+import (
+	"errors"
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"sort"
+	"strings"
+)
+
+// Metadata overrides whatever version details the source file declares.
+// Empty fields keep the value read from the file.
+type Metadata struct {
+	Name         string
+	Abbreviation string
+	Language     string
+	// License is the copyright notice and Attribution the short line
+	// projected with the verses of a licensed translation.
+	License     string
+	Attribution string
+	// Versification names the numbering scheme in versification_mappings;
+	// empty means the standard one.
+	Versification string
+}
+
+func (m Metadata) apply(bible *entities.BibleImport) {
+	if name := strings.TrimSpace(m.Name); name != "" {
+		bible.Name = name
+	}
+	if abbreviation := strings.TrimSpace(m.Abbreviation); abbreviation != "" {
+		bible.Abbreviation = abbreviation
+	}
+	if language := strings.TrimSpace(m.Language); language != "" {
+		bible.Language = language
+	}
+	if license := strings.TrimSpace(m.License); license != "" {
+		bible.License = license
+	}
+	if attribution := strings.TrimSpace(m.Attribution); attribution != "" {
+		bible.Attribution = attribution
+	}
+	if versification := strings.TrimSpace(m.Versification); versification != "" {
+		bible.Versification = versification
+	}
+	if bible.Name == "" {
+		bible.Name = bible.Abbreviation
+	}
+}
+
+func validate(bible *entities.BibleImport) error {
+	if bible.Name == "" {
+		return errors.New("bible name is required")
+	}
+	if len(bible.Books) == 0 {
+		return errors.New("no known books found in source")
+	}
+	return nil
+}
+
+// builder accumulates books, chapters and verses in document order. Headings
+// seen before a chapter's first verse become the chapter research; later ones
+// are attached to the verse that follows them.
+type builder struct {
+	bible   entities.BibleImport
+	books   map[string]int
+	heading string
+}
+
+func (b *builder) addHeading(text string) {
+	text = collapseSpaces(text)
+	if text == "" {
+		return
+	}
+	if b.heading != "" {
+		b.heading += " / " + text
+		return
+	}
+	b.heading = text
+}
+
+// addVerse keeps a bridge such as 3-4 as one verse: the text goes under 3
+// and last records 4, so a lookup of 4 lands on it and the chapter size
+// stays right.
+func (b *builder) addVerse(book string, chapter int, verse int, last int, text string) error {
+	if _, ok := consts.Books[book]; !ok {
+		return fmt.Errorf("unknown book %q", book)
+	}
+	if chapter <= 0 || verse <= 0 {
+		return fmt.Errorf("invalid reference %s %d:%d", book, chapter, verse)
+	}
+	text = collapseSpaces(text)
+	if text == "" {
+		return nil
+	}
+	if last <= verse {
+		last = 0
+	}
+
+	current := b.chapter(book, chapter)
+	item := entities.ImportVerse{Index: verse, Last: last, Text: text}
+	if b.heading != "" {
+		if len(current.Verses) == 0 && current.Research == "" {
+			current.Research = b.heading
+		} else {
+			item.Research = b.heading
+		}
+		b.heading = ""
+	}
+	if previous := len(current.Verses) - 1; previous >= 0 && current.Verses[previous].Index == verse {
+		current.Verses[previous].Text += " " + item.Text
+		if current.Verses[previous].Research == "" {
+			current.Verses[previous].Research = item.Research
+		}
+		return nil
+	}
+	current.Verses = append(current.Verses, item)
+	return nil
+}
+
+func (b *builder) chapter(book string, chapter int) *entities.ImportChapter {
+	if b.books == nil {
+		b.books = map[string]int{}
+	}
+	position, ok := b.books[book]
+	if !ok {
+		b.bible.Books = append(b.bible.Books, entities.ImportBook{Name: book, Title: consts.Books[book]})
+		position = len(b.bible.Books) - 1
+		b.books[book] = position
+	}
+	current := &b.bible.Books[position]
+	for i := range current.Chapters {
+		if current.Chapters[i].Index == chapter {
+			return &current.Chapters[i]
+		}
+	}
+	current.Chapters = append(current.Chapters, entities.ImportChapter{Index: chapter})
+	return &current.Chapters[len(current.Chapters)-1]
+}
+
+func (b *builder) finish(meta Metadata) (*entities.BibleImport, error) {
+	order := make(map[string]int, len(consts.BookOrder))
+	for i, slug := range consts.BookOrder {
+		order[slug] = i
+	}
+	sort.SliceStable(b.bible.Books, func(i, j int) bool {
+		return order[b.bible.Books[i].Name] < order[b.bible.Books[j].Name]
+	})
+	for i := range b.bible.Books {
+		chapters := b.bible.Books[i].Chapters
+		sort.SliceStable(chapters, func(i, j int) bool {
+			return chapters[i].Index < chapters[j].Index
+		})
+		for j := range chapters {
+			verses := chapters[j].Verses
+			sort.SliceStable(verses, func(i, j int) bool {
+				return verses[i].Index < verses[j].Index
+			})
+		}
+	}
+
+	meta.apply(&b.bible)
+	if err := validate(&b.bible); err != nil {
+		return nil, err
+	}
+	return &b.bible, nil
+}
+
+func collapseSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
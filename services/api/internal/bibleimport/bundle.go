@@ -0,0 +1,165 @@
+package bibleimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"services/api/domain/entities"
+	"sort"
+	"strings"
+)
+
+// bundleFile is one book of a USFM/USX bundle.
+type bundleFile struct {
+	name string
+	open func() (io.ReadCloser, error)
+}
+
+// bundleMetadataFile describes the version inside a bundle, with the same
+// fields as the JSON envelope: {"name":..., "abbreviation":..., "language":...,
+// "license":..., "attribution":...}.
+const bundleMetadataFile = "metadata.json"
+
+// ParseUSFM reads a single USFM book or a zip of USFM/USX books. Inside a
+// zip each file is parsed according to its extension.
+func ParseUSFM(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return parseBundleReader(r, "book.usfm", meta)
+}
+
+// ParseUSX reads a single USX book or a zip of USFM/USX books.
+func ParseUSX(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return parseBundleReader(r, "book.usx", meta)
+}
+
+func parseBundleReader(r io.Reader, single string, meta Metadata) (*entities.BibleImport, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isZip(content) {
+		var b builder
+		if err := parseBundleFile(&b, single, bytes.NewReader(content)); err != nil {
+			return nil, err
+		}
+		return b.finish(meta)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip: %w", err)
+	}
+	files := make([]bundleFile, 0, len(archive.File))
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, bundleFile{name: f.Name, open: f.Open})
+	}
+	return parseBundle(files, meta)
+}
+
+// ParseDir reads every USFM/USX book found in a folder.
+func ParseDir(dir string, meta Metadata) (*entities.BibleImport, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]bundleFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		full := filepath.Join(dir, entry.Name())
+		files = append(files, bundleFile{
+			name: entry.Name(),
+			open: func() (io.ReadCloser, error) { return os.Open(full) },
+		})
+	}
+	return parseBundle(files, meta)
+}
+
+func parseBundle(files []bundleFile, meta Metadata) (*entities.BibleImport, error) {
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+
+	var b builder
+	parsed := 0
+	for _, file := range files {
+		if strings.EqualFold(path.Base(file.name), bundleMetadataFile) {
+			if err := readBundleMetadata(&b, file); err != nil {
+				return nil, fmt.Errorf("%s: %w", file.name, err)
+			}
+			continue
+		}
+		if !isBundleBook(file.name) {
+			continue
+		}
+		src, err := file.open()
+		if err != nil {
+			return nil, err
+		}
+		err = parseBundleFile(&b, file.name, src)
+		src.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.name, err)
+		}
+		parsed++
+	}
+	if parsed == 0 {
+		return nil, errors.New("no usfm or usx books found")
+	}
+	return b.finish(meta)
+}
+
+func readBundleMetadata(b *builder, file bundleFile) error {
+	src, err := file.open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	var doc flatBible
+	if err := json.NewDecoder(src).Decode(&doc); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+	b.bible.Name = collapseSpaces(doc.Name)
+	b.bible.Abbreviation = collapseSpaces(doc.Abbreviation)
+	b.bible.Language = collapseSpaces(doc.Language)
+	b.bible.License = strings.TrimSpace(doc.License)
+	b.bible.Attribution = collapseSpaces(doc.Attribution)
+	return nil
+}
+
+func parseBundleFile(b *builder, name string, r io.Reader) error {
+	if strings.EqualFold(path.Ext(name), ".usx") {
+		return parseUSXBook(b, r)
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return parseUSFMBook(b, string(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+}
+
+// isZip reports whether content starts like a zip archive: with its first
+// file, or with the end record when the archive is empty.
+func isZip(content []byte) bool {
+	return bytes.HasPrefix(content, []byte("PK\x03\x04")) || bytes.HasPrefix(content, []byte("PK\x05\x06"))
+}
+
+func isBundleBook(name string) bool {
+	if strings.HasPrefix(path.Base(name), ".") {
+		return false
+	}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".usfm", ".sfm", ".ptx", ".usx":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package bibleimport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"services/api/internal/bibleimport"
+	"testing"
+)
+
+func TestParseUSFM_Bundle(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected []string
+	}{
+		{
+			name: "should read every book of a zip in canonical order",
+			files: map[string]string{
+				"43JHN.usfm": "\\id JHN\n\\c 3\n\\v 16 Porque de tal manera",
+				"01GEN.usx":  `<usx><book code="GEN"/><chapter number="1"/><para style="p"><verse number="1"/>En el principio</para></usx>`,
+				"LEEME.txt":  "Reina Valera",
+			},
+			expected: []string{"genesis 1:1 En el principio", "juan 3:16 Porque de tal manera"},
+		},
+		{
+			name: "should read books nested in folders and skip hidden files",
+			files: map[string]string{
+				"rv1909/usfm/":                 "",
+				"rv1909/usfm/43JHN.SFM":        "\\id JHN\n\\c 3\n\\v 16 Porque de tal manera",
+				"__MACOSX/rv1909/usfm/._43JHN": "\x00\x05\x16\x07",
+				"rv1909/usfm/.43JHN.usfm":      "\\id JHN\n\\c 3\n\\v 16 Copia",
+			},
+			expected: []string{"juan 3:16 Porque de tal manera"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseUSFM(bytes.NewReader(zipFiles(t, tt.files)), bibleimport.Metadata{Name: "RV1909"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should read the version from metadata.json", func(t *testing.T) {
+		content := zipFiles(t, map[string]string{
+			"rv1909/metadata.json": `{"name":" Reina  Valera 1909 ","abbreviation":"RV1909","language":"es","license":"Dominio público"}`,
+			"rv1909/43JHN.usfm":    "\\id JHN\n\\c 3\n\\v 16 Porque",
+		})
+
+		bible, err := bibleimport.ParseUSX(bytes.NewReader(content), bibleimport.Metadata{Language: "es-ES"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reina Valera 1909", bible.Name)
+		assert.Equal(t, "RV1909", bible.Abbreviation)
+		assert.Equal(t, "es-ES", bible.Language)
+		assert.Equal(t, "Dominio público", bible.License)
+	})
+
+	t.Run("should fail on a zip without books", func(t *testing.T) {
+		archives := map[string]map[string]string{
+			"empty":        {},
+			"only folders": {"rv1909/": "", "rv1909/usfm/": ""},
+			"other files":  {"LEEME.txt": "Reina Valera", "rv1909/metadata.json": `{"name":"RV1909"}`},
+		}
+		for name, files := range archives {
+			_, err := bibleimport.ParseUSFM(bytes.NewReader(zipFiles(t, files)), bibleimport.Metadata{Name: "RV1909"})
+
+			assert.ErrorContains(t, err, "no usfm or usx books found", name)
+		}
+	})
+
+	t.Run("should name the book that fails", func(t *testing.T) {
+		content := zipFiles(t, map[string]string{"rv1909/43JHN.usfm": "\\id JHN\n\\c tres\n\\v 16 Porque"})
+
+		_, err := bibleimport.ParseUSFM(bytes.NewReader(content), bibleimport.Metadata{Name: "RV1909"})
+
+		assert.ErrorContains(t, err, `rv1909/43JHN.usfm: invalid chapter "tres"`)
+	})
+
+	t.Run("should fail on a broken zip", func(t *testing.T) {
+		_, err := bibleimport.ParseUSFM(bytes.NewReader([]byte("PK\x03\x04roto")), bibleimport.Metadata{Name: "RV1909"})
+
+		assert.ErrorContains(t, err, "invalid zip")
+	})
+}
+
+func TestParseDir(t *testing.T) {
+	t.Run("should read the books of a folder", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "43JHN.usfm"), []byte("\\id JHN\n\\c 3\n\\v 16 Porque de tal manera"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata.json"), []byte(`{"name":"Reina Valera 1909"}`), 0o644))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "notas"), 0o755))
+
+		bible, err := bibleimport.ParseDir(dir, bibleimport.Metadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reina Valera 1909", bible.Name)
+		assert.Equal(t, []string{"juan 3:16 Porque de tal manera"}, describeBible(bible))
+	})
+
+	t.Run("should fail on a folder without books", func(t *testing.T) {
+		_, err := bibleimport.ParseDir(t.TempDir(), bibleimport.Metadata{Name: "RV1909"})
+
+		assert.ErrorContains(t, err, "no usfm or usx books found")
+	})
+}
+
+// zipFiles builds a zip archive holding files by name; a name ending in a
+// slash is stored as a folder.
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
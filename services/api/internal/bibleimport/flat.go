@@ -0,0 +1,236 @@
+package bibleimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+)
+
+// flatVerse is one row of a "book,chapter,verse,text" file. Book and verse
+// may be given as numbers or strings; Heading is an optional section heading
+// shown before the verse.
+type flatVerse struct {
+	Book    json.RawMessage `json:"book"`
+	Chapter json.Number     `json:"chapter"`
+	Verse   json.RawMessage `json:"verse"`
+	Text    string          `json:"text"`
+	Heading string          `json:"heading"`
+}
+
+// flatBible is the JSON envelope; a bare array of verses is accepted too.
+type flatBible struct {
+	Name         string      `json:"name"`
+	Abbreviation string      `json:"abbreviation"`
+	Language     string      `json:"language"`
+	License      string      `json:"license"`
+	Attribution  string      `json:"attribution"`
+	Verses       []flatVerse `json:"verses"`
+}
+
+type jsonImporter struct{}
+
+func (jsonImporter) Format() string       { return FormatJSON }
+func (jsonImporter) Extensions() []string { return []string{".json"} }
+func (jsonImporter) Sniff(head []byte) bool {
+	trimmed := bytes.TrimSpace(head)
+	return bytes.HasPrefix(trimmed, []byte("[")) || bytes.HasPrefix(trimmed, []byte("{"))
+}
+func (jsonImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseJSON(r, meta)
+}
+
+type csvImporter struct{}
+
+func (csvImporter) Format() string       { return FormatCSV }
+func (csvImporter) Extensions() []string { return []string{".csv", ".tsv"} }
+func (csvImporter) Sniff(head []byte) bool {
+	firstLine, _, _ := bytes.Cut(head, []byte("\n"))
+	return bytes.ContainsAny(firstLine, ",;\t")
+}
+func (csvImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseCSV(r, meta)
+}
+
+// ParseJSON reads verses as [{"book":"genesis","chapter":1,"verse":1,"text":"..."}]
+// or wrapped in {"name":..., "abbreviation":..., "language":..., "license":...,
+// "attribution":..., "verses":[...]}.
+func ParseJSON(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc flatBible
+	if trimmed := bytes.TrimSpace(content); bytes.HasPrefix(trimmed, []byte("[")) {
+		err = json.Unmarshal(trimmed, &doc.Verses)
+	} else {
+		err = json.Unmarshal(trimmed, &doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	b := builder{bible: entities.BibleImport{
+		Name:         doc.Name,
+		Abbreviation: doc.Abbreviation,
+		Language:     doc.Language,
+		License:      doc.License,
+		Attribution:  doc.Attribution,
+	}}
+	for i, row := range doc.Verses {
+		book := strings.Trim(string(row.Book), `"`)
+		verse := strings.Trim(string(row.Verse), `"`)
+		if err := addFlatVerse(&b, book, row.Chapter.String(), verse, row.Text, row.Heading); err != nil {
+			return nil, fmt.Errorf("verse %d: %w", i+1, err)
+		}
+	}
+	return b.finish(meta)
+}
+
+// ParseCSV reads "book,chapter,verse,text" rows. A header row is optional;
+// when present it may add a heading column and reorder the others. Comma,
+// semicolon and tab separators are detected from the first line.
+func ParseCSV(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	buffered := bufio.NewReader(r)
+	head, _ := buffered.Peek(1024)
+	if bytes.HasPrefix(head, []byte("\xef\xbb\xbf")) {
+		_, _ = buffered.Discard(3)
+		head = head[3:]
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.Comma = detectSeparator(head)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	columns := map[string]int{"book": 0, "chapter": 1, "verse": 2, "text": 3}
+	var b builder
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		line++
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if line == 1 && isCSVHeader(record) {
+			columns = csvColumns(record)
+			continue
+		}
+
+		field := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return record[index]
+		}
+		text := field("text")
+		if _, hasHeader := columns["heading"]; !hasHeader && len(record) > 4 {
+			text = strings.Join(record[3:], string(reader.Comma))
+		}
+		if err := addFlatVerse(&b, field("book"), field("chapter"), field("verse"), text, field("heading")); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return b.finish(meta)
+}
+
+func addFlatVerse(b *builder, book string, chapter string, verse string, text string, heading string) error {
+	slug, ok := resolveBook(book)
+	if !ok {
+		return fmt.Errorf("unknown book %q", book)
+	}
+	chapterNumber, err := strconv.Atoi(strings.TrimSpace(chapter))
+	if err != nil {
+		return fmt.Errorf("invalid chapter %q", chapter)
+	}
+	first, last, err := parseVerseBridge(strings.TrimSpace(verse))
+	if err != nil {
+		return err
+	}
+	b.addHeading(heading)
+	return b.addVerse(slug, chapterNumber, first, last, text)
+}
+
+func detectSeparator(head []byte) rune {
+	firstLine, _, _ := bytes.Cut(head, []byte("\n"))
+	best, bestCount := ',', bytes.Count(firstLine, []byte(","))
+	for _, candidate := range []rune{';', '\t'} {
+		if count := bytes.Count(firstLine, []byte(string(candidate))); count > bestCount {
+			best, bestCount = candidate, count
+		}
+	}
+	return best
+}
+
+func isCSVHeader(record []string) bool {
+	if len(record) < 2 {
+		return false
+	}
+	_, err := strconv.Atoi(strings.TrimSpace(record[1]))
+	return err != nil
+}
+
+func csvColumns(header []string) map[string]int {
+	aliases := map[string]string{
+		"book": "book", "libro": "book",
+		"chapter": "chapter", "capitulo": "chapter",
+		"verse": "verse", "versiculo": "verse",
+		"text": "text", "texto": "text",
+		"heading": "heading", "titulo": "heading",
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		if column, ok := aliases[bookKey(name)]; ok {
+			columns[column] = i
+		}
+	}
+	return columns
+}
+
+// resolveBook accepts a slug, a display name from consts.Books, an OSIS or
+// USFM code, or a canonical book number.
+func resolveBook(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if number, err := strconv.Atoi(value); err == nil {
+		if number >= 1 && number <= len(consts.BookOrder) {
+			return consts.BookOrder[number-1], true
+		}
+		return "", false
+	}
+	if slug, ok := consts.OSISBooks[value]; ok {
+		return slug, true
+	}
+	if slug, ok := consts.USFMBooks[strings.ToUpper(value)]; ok {
+		return slug, true
+	}
+	key := bookKey(value)
+	if _, ok := consts.Books[key]; ok {
+		return key, true
+	}
+	return "", false
+}
+
+var bookKeyReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+	" ", "-", "_", "-",
+)
+
+// bookKey folds "1 Crónicas" into the slug form "1-cronicas".
+func bookKey(value string) string {
+	return bookKeyReplacer.Replace(strings.ToLower(strings.TrimSpace(value)))
+}
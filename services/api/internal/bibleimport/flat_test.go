@@ -0,0 +1,128 @@
+package bibleimport_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "should read rows without a header",
+			content:  "juan,3,16,Porque de tal manera\njuan,3,17,Porque no envió Dios\n",
+			expected: []string{"juan 3:16 Porque de tal manera", "juan 3:17 Porque no envió Dios"},
+		},
+		{
+			name:     "should map the columns of a header",
+			content:  "texto;versiculo;capitulo;libro;titulo\nEn el principio;1;1;Génesis;La creación\nY la tierra;2;1;Génesis;\n",
+			expected: []string{"genesis 1: La creación", "genesis 1:1 En el principio", "genesis 1:2 Y la tierra"},
+		},
+		{
+			name:     "should accept book codes and numbers",
+			content:  "book\tchapter\tverse\ttext\nJHN\t3\t16\tFor God\n1 Juan\t4\t8\tDios es amor\n65\t1\t1\tJudas\nRom\t3\t25-26\tA quien Dios puso\n",
+			expected: []string{"juan 3:16 For God", "romanos 3:25-26 A quien Dios puso", "1-juan 4:8 Dios es amor", "judas 1:1 Judas"},
+		},
+		{
+			name:     "should keep separators inside the text",
+			content:  "\xef\xbb\xbfjuan,11,35,Jesús lloró, y los judíos\n\n",
+			expected: []string{"juan 11:35 Jesús lloró, y los judíos"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseCSV(strings.NewReader(tt.content), bibleimport.Metadata{Name: "Prueba"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should fail on a bad row with its line", func(t *testing.T) {
+		bad := []struct {
+			content  string
+			expected string
+		}{
+			{content: "libro,capitulo,versiculo,texto\njuan,3,16,Porque\nxyz,3,17,Porque\n", expected: `line 3: unknown book "xyz"`},
+			{content: "juan,3,15,Porque\njuan,tres,16,Porque\n", expected: `line 2: invalid chapter "tres"`},
+			{content: "juan,3,dieciseis,Porque\n", expected: `line 1: invalid verse "dieciseis"`},
+			{content: "juan,0,16,Porque\n", expected: "line 1: invalid reference juan 0:16"},
+			{content: "juan,3\n", expected: `line 1: invalid verse ""`},
+		}
+		for _, tt := range bad {
+			_, err := bibleimport.ParseCSV(strings.NewReader(tt.content), bibleimport.Metadata{Name: "Prueba"})
+
+			assert.ErrorContains(t, err, tt.expected, tt.content)
+		}
+	})
+}
+
+func TestParseJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "should read a bare array",
+			content:  `[{"book":"juan","chapter":3,"verse":16,"text":"Porque de tal manera"}]`,
+			expected: []string{"juan 3:16 Porque de tal manera"},
+		},
+		{
+			name:     "should read numbers and strings for books and verses",
+			content:  `{"verses":[{"book":43,"chapter":"3","verse":"16","text":"Porque"},{"book":"Rom","chapter":3,"verse":"25-26","text":"A quien","heading":"Justificados"}]}`,
+			expected: []string{"juan 3:16 Porque", "romanos 3: Justificados", "romanos 3:25-26 A quien"},
+		},
+		{
+			name:     "should join the text of a verse given twice",
+			content:  `[{"book":"juan","chapter":3,"verse":16,"text":"Porque de tal manera"},{"book":"juan","chapter":3,"verse":16,"text":"amó Dios al mundo"}]`,
+			expected: []string{"juan 3:16 Porque de tal manera amó Dios al mundo"},
+		},
+		{
+			name:     "should skip verses without text",
+			content:  `[{"book":"juan","chapter":3,"verse":15,"text":"  "},{"book":"juan","chapter":3,"verse":16,"text":"Porque"}]`,
+			expected: []string{"juan 3:16 Porque"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseJSON(strings.NewReader(tt.content), bibleimport.Metadata{Name: "Prueba"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should read the version from the envelope", func(t *testing.T) {
+		content := `{"name":"Nueva Versión","abbreviation":"NV","language":"es","license":"© Biblica","attribution":"NV © Biblica","verses":[{"book":"juan","chapter":3,"verse":16,"text":"Porque"}]}`
+
+		bible, err := bibleimport.ParseJSON(strings.NewReader(content), bibleimport.Metadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Nueva Versión", bible.Name)
+		assert.Equal(t, "© Biblica", bible.License)
+		assert.Equal(t, "NV © Biblica", bible.Attribution)
+	})
+
+	t.Run("should fail on a bad verse with its position", func(t *testing.T) {
+		bad := []struct {
+			content  string
+			expected string
+		}{
+			{content: `[{"book":"juan","chapter":3,"verse":16,"text":"Porque"},{"book":"hechos de juan","chapter":1,"verse":1,"text":"x"}]`, expected: `verse 2: unknown book "hechos de juan"`},
+			{content: `[{"book":"juan","chapter":3,"verse":"16a-b","text":"Porque"}]`, expected: `verse 1: invalid verse "16a-b"`},
+			{content: `[{"book":"juan","chapter":3,"verse":16,"text":"Porque"}`, expected: "invalid json"},
+		}
+		for _, tt := range bad {
+			_, err := bibleimport.ParseJSON(strings.NewReader(tt.content), bibleimport.Metadata{Name: "Prueba"})
+
+			assert.ErrorContains(t, err, tt.expected, tt.content)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package bibleimport
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"services/api/domain/entities"
+	"strings"
+)
+
+const (
+	FormatOSIS    = "osis"
+	FormatUSFM    = "usfm"
+	FormatUSX     = "usx"
+	FormatZefania = "zefania"
+	FormatCSV     = "csv"
+	FormatJSON    = "json"
+)
+
+// Importer parses one source format into a bible ready to be stored.
+type Importer interface {
+	// Format is the name accepted by Parse and the import endpoints.
+	Format() string
+	// Extensions lists file extensions that unambiguously use this format.
+	Extensions() []string
+	// Sniff reports whether the first bytes of a file look like this format.
+	Sniff(head []byte) bool
+	Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error)
+}
+
+// importers are tried in order when sniffing, so formats with a clear
+// signature come before the permissive flat files.
+var importers = []Importer{
+	osisImporter{},
+	zefaniaImporter{},
+	usxImporter{},
+	usfmImporter{},
+	jsonImporter{},
+	csvImporter{},
+}
+
+// Formats lists the supported import formats.
+func Formats() []string {
+	formats := make([]string, 0, len(importers))
+	for _, importer := range importers {
+		formats = append(formats, importer.Format())
+	}
+	return formats
+}
+
+// DetectFormat guesses the import format from a file name. It returns an
+// empty string when the extension is shared by several formats (.xml), in
+// which case Parse looks at the content instead.
+func DetectFormat(filename string) string {
+	ext := strings.ToLower(filepath.Ext(filename))
+	for _, importer := range importers {
+		for _, candidate := range importer.Extensions() {
+			if candidate == ext {
+				return importer.Format()
+			}
+		}
+	}
+	return ""
+}
+
+// Parse reads a bible in the given format, or detects the format from the
+// content when none is given.
+func Parse(format string, r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		buffered := bufio.NewReader(r)
+		head, _ := buffered.Peek(512)
+		importer := sniff(head)
+		if importer == nil {
+			return nil, errors.New("cannot detect import format")
+		}
+		return importer.Parse(buffered, meta)
+	}
+
+	for _, importer := range importers {
+		if importer.Format() == format {
+			return importer.Parse(r, meta)
+		}
+	}
+	return nil, fmt.Errorf("unsupported import format %q", format)
+}
+
+func sniff(head []byte) Importer {
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	for _, importer := range importers {
+		if importer.Sniff(head) {
+			return importer
+		}
+	}
+	return nil
+}
+
+// xmlRoot returns the name of the first element in an XML prefix.
+func xmlRoot(head []byte) string {
+	for {
+		start := bytes.IndexByte(head, '<')
+		if start < 0 || start+1 >= len(head) {
+			return ""
+		}
+		head = head[start+1:]
+		if head[0] == '?' || head[0] == '!' {
+			continue
+		}
+		end := bytes.IndexAny(head, " \t\r\n/>")
+		if end < 0 {
+			return string(head)
+		}
+		return string(head[:end])
+	}
+}
+
+type osisImporter struct{}
+
+func (osisImporter) Format() string       { return FormatOSIS }
+func (osisImporter) Extensions() []string { return []string{".osis"} }
+func (osisImporter) Sniff(head []byte) bool {
+	return strings.EqualFold(xmlRoot(head), "osis")
+}
+func (osisImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseOSIS(r, meta)
+}
+
+type usfmImporter struct{}
+
+func (usfmImporter) Format() string       { return FormatUSFM }
+func (usfmImporter) Extensions() []string { return []string{".usfm", ".sfm", ".ptx", ".zip"} }
+func (usfmImporter) Sniff(head []byte) bool {
+	return isZip(head) || bytes.HasPrefix(bytes.TrimSpace(head), []byte(`\id `))
+}
+func (usfmImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseUSFM(r, meta)
+}
+
+type usxImporter struct{}
+
+func (usxImporter) Format() string       { return FormatUSX }
+func (usxImporter) Extensions() []string { return []string{".usx"} }
+func (usxImporter) Sniff(head []byte) bool {
+	return strings.EqualFold(xmlRoot(head), "usx")
+}
+func (usxImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseUSX(r, meta)
+}
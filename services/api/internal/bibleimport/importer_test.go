@@ -0,0 +1,161 @@
+package bibleimport_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		filename string
+		expected string
+	}{
+		{filename: "kjv.osis", expected: bibleimport.FormatOSIS},
+		{filename: "GEN.SFM", expected: bibleimport.FormatUSFM},
+		{filename: "web.zip", expected: bibleimport.FormatUSFM},
+		{filename: "40MAT.usx", expected: bibleimport.FormatUSX},
+		{filename: "nvi.tsv", expected: bibleimport.FormatCSV},
+		{filename: "nvi.json", expected: bibleimport.FormatJSON},
+		{filename: "kjv.xml", expected: ""},
+		{filename: "LEEME", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			assert.Equal(t, tt.expected, bibleimport.DetectFormat(tt.filename))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "should sniff osis",
+			content:  `<?xml version="1.0"?><!-- KJV --><osis><osisText><div><verse osisID="Gen.1.1">In the beginning</verse></div></osisText></osis>`,
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+		{
+			name:     "should sniff zefania",
+			content:  `<XMLBIBLE biblename="KJV"><BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><VERS vnumber="1">In the beginning</VERS></CHAPTER></BIBLEBOOK></XMLBIBLE>`,
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+		{
+			name:     "should sniff usx",
+			content:  `<usx version="3.0"><book code="GEN" style="id"/><chapter number="1"/><para style="p"><verse number="1"/>In the beginning</para></usx>`,
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+		{
+			name:     "should sniff usfm behind a byte order mark",
+			content:  "\xef\xbb\xbf\\id GEN\n\\c 1\n\\v 1 In the beginning",
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+		{
+			name:     "should sniff json",
+			content:  ` [{"book":"genesis","chapter":1,"verse":1,"text":"In the beginning"}]`,
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+		{
+			name:     "should sniff csv",
+			content:  "genesis;1;1;In the beginning",
+			expected: []string{"genesis 1:1 In the beginning"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.Parse("", strings.NewReader(tt.content), bibleimport.Metadata{Name: "Prueba"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should sniff a zip of books, even an empty one", func(t *testing.T) {
+		bible, err := bibleimport.Parse("", bytes.NewReader(zipFiles(t, map[string]string{"43JHN.usfm": "\\id JHN\n\\c 3\n\\v 16 Porque"})), bibleimport.Metadata{Name: "Prueba"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"juan 3:16 Porque"}, describeBible(bible))
+
+		_, err = bibleimport.Parse("", bytes.NewReader(zipFiles(t, nil)), bibleimport.Metadata{Name: "Prueba"})
+		assert.ErrorContains(t, err, "no usfm or usx books found")
+	})
+
+	t.Run("should use the format it is given", func(t *testing.T) {
+		bible, err := bibleimport.Parse(" CSV ", strings.NewReader("juan,3,16,Porque de tal manera"), bibleimport.Metadata{Name: "Prueba"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"juan 3:16 Porque de tal manera"}, describeBible(bible))
+	})
+
+	t.Run("should fail on an unknown format", func(t *testing.T) {
+		_, err := bibleimport.Parse("pdf", strings.NewReader("juan,3,16,Porque"), bibleimport.Metadata{Name: "Prueba"})
+
+		assert.ErrorContains(t, err, `unsupported import format "pdf"`)
+	})
+
+	t.Run("should fail when the content matches no format", func(t *testing.T) {
+		_, err := bibleimport.Parse("", strings.NewReader("En el principio"), bibleimport.Metadata{Name: "Prueba"})
+
+		assert.ErrorContains(t, err, "cannot detect import format")
+	})
+}
+
+func TestMetadata(t *testing.T) {
+	t.Run("should override the details read from the file", func(t *testing.T) {
+		content := `{"name":"Nueva Versión","abbreviation":"NV","language":"es","verses":[{"book":"juan","chapter":3,"verse":16,"text":"Porque"}]}`
+
+		bible, err := bibleimport.ParseJSON(strings.NewReader(content), bibleimport.Metadata{Abbreviation: " NVI ", Versification: "lxx"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Nueva Versión", bible.Name)
+		assert.Equal(t, "NVI", bible.Abbreviation)
+		assert.Equal(t, "es", bible.Language)
+		assert.Equal(t, "lxx", bible.Versification)
+	})
+
+	t.Run("should fall back to the abbreviation for the name", func(t *testing.T) {
+		bible, err := bibleimport.ParseCSV(strings.NewReader("juan,3,16,Porque"), bibleimport.Metadata{Abbreviation: "NVI"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "NVI", bible.Name)
+	})
+
+	t.Run("should fail without a name", func(t *testing.T) {
+		_, err := bibleimport.ParseCSV(strings.NewReader("juan,3,16,Porque"), bibleimport.Metadata{})
+
+		assert.ErrorContains(t, err, "bible name is required")
+	})
+}
+
+// describeBible writes a chapter heading as "<book> <chapter>: <research>"
+// and every verse as "<book> <chapter>:<verse> [<research>] <text>", with
+// the end of a bridge after a dash.
+func describeBible(bible *entities.BibleImport) []string {
+	described := []string{}
+	for _, book := range bible.Books {
+		for _, chapter := range book.Chapters {
+			if chapter.Research != "" {
+				described = append(described, fmt.Sprintf("%s %d: %s", book.Name, chapter.Index, chapter.Research))
+			}
+			for _, verse := range chapter.Verses {
+				number := fmt.Sprint(verse.Index)
+				if verse.Last > 0 {
+					number = fmt.Sprintf("%d-%d", verse.Index, verse.Last)
+				}
+				text := verse.Text
+				if verse.Research != "" {
+					text = "[" + verse.Research + "] " + text
+				}
+				described = append(described, fmt.Sprintf("%s %d:%s %s", book.Name, chapter.Index, number, text))
+			}
+		}
+	}
+	return described
+}
@@ -0,0 +1,222 @@
+package bibleimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+)
+
+type osisVerse struct {
+	book      string
+	chapter   int
+	verse     int
+	last      int
+	milestone bool
+	text      strings.Builder
+}
+
+// ParseOSIS reads an OSIS XML document. Both container (<verse>text</verse>)
+// and milestone (<verse sID/> ... <verse eID/>) verses are supported; notes
+// are dropped and section titles become research headings. Books outside
+// consts.OSISBooks (deuterocanon, appendices) are skipped.
+func ParseOSIS(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	decoder := xml.NewDecoder(r)
+
+	var (
+		b         builder
+		verse     *osisVerse
+		inHeader  bool
+		inWork    bool
+		skipDepth int
+		title     *strings.Builder
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid osis xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			switch t.Name.Local {
+			case "osisText":
+				b.bible.Abbreviation = attr(t, "osisIDWork")
+				b.bible.Language = attr(t, "lang")
+			case "header":
+				inHeader = true
+			case "work":
+				inWork = inHeader && b.bible.Name == ""
+			case "note", "rdg":
+				skipDepth = 1
+			case "title":
+				if inHeader {
+					if inWork {
+						title = &strings.Builder{}
+					}
+					continue
+				}
+				switch attr(t, "type") {
+				case "", "section", "sub", "parallel", "psalm":
+					title = &strings.Builder{}
+				default:
+					skipDepth = 1
+				}
+			case "language":
+				if inWork && b.bible.Language == "" {
+					title = &strings.Builder{}
+				}
+			case "rights":
+				if inWork && b.bible.License == "" {
+					title = &strings.Builder{}
+				}
+			case "verse":
+				if eID := attr(t, "eID"); eID != "" {
+					if err := flushOSISVerse(&b, verse); err != nil {
+						return nil, err
+					}
+					verse = nil
+					continue
+				}
+				id := attr(t, "sID")
+				milestone := id != ""
+				if !milestone {
+					id = attr(t, "osisID")
+				}
+				if err := flushOSISVerse(&b, verse); err != nil {
+					return nil, err
+				}
+				verse = parseOSISVerseID(id)
+				verse.milestone = milestone
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			switch t.Name.Local {
+			case "header":
+				inHeader = false
+			case "work":
+				inWork = false
+			case "title":
+				if title == nil {
+					continue
+				}
+				if inHeader {
+					b.bible.Name = collapseSpaces(title.String())
+				} else {
+					b.addHeading(title.String())
+				}
+				title = nil
+			case "language":
+				if title != nil {
+					b.bible.Language = collapseSpaces(title.String())
+					title = nil
+				}
+			case "rights":
+				if title != nil {
+					b.bible.License = collapseSpaces(title.String())
+					title = nil
+				}
+			case "verse":
+				if verse == nil || verse.milestone {
+					continue
+				}
+				if err := flushOSISVerse(&b, verse); err != nil {
+					return nil, err
+				}
+				verse = nil
+			case "l", "lg", "p":
+				if verse != nil {
+					verse.text.WriteByte(' ')
+				}
+			}
+		case xml.CharData:
+			if skipDepth > 0 {
+				continue
+			}
+			if title != nil {
+				title.Write(t)
+				continue
+			}
+			if verse != nil {
+				verse.text.Write(t)
+			}
+		}
+	}
+
+	if err := flushOSISVerse(&b, verse); err != nil {
+		return nil, err
+	}
+
+	return b.finish(meta)
+}
+
+func flushOSISVerse(b *builder, verse *osisVerse) error {
+	if verse == nil || verse.book == "" {
+		return nil
+	}
+	return b.addVerse(verse.book, verse.chapter, verse.verse, verse.last, verse.text.String())
+}
+
+// parseOSISVerseID turns "Gen.1.1", "KJV:Gen.1.1" or a space separated list
+// of merged verses into a reference. Unknown books yield a verse with no book
+// so its text is consumed and discarded.
+func parseOSISVerseID(id string) *osisVerse {
+	fields := strings.Fields(id)
+	if len(fields) == 0 {
+		return &osisVerse{}
+	}
+	verse := splitOSISID(fields[0])
+	if len(fields) > 1 {
+		if last := splitOSISID(fields[len(fields)-1]); last.book == verse.book && last.chapter == verse.chapter {
+			verse.last = last.verse
+		}
+	}
+	return verse
+}
+
+func splitOSISID(id string) *osisVerse {
+	if idx := strings.Index(id, ":"); idx >= 0 {
+		id = id[idx+1:]
+	}
+	parts := strings.Split(id, ".")
+	if len(parts) != 3 {
+		return &osisVerse{}
+	}
+	chapter, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return &osisVerse{}
+	}
+	verse, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return &osisVerse{}
+	}
+	return &osisVerse{
+		book:    consts.OSISBooks[parts[0]],
+		chapter: chapter,
+		verse:   verse,
+	}
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
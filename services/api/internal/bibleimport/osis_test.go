@@ -0,0 +1,105 @@
+package bibleimport_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestParseOSIS(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "should read container verses",
+			body: `<div type="book" osisID="Gen"><chapter osisID="Gen.1">
+				<verse osisID="Gen.1.1">In the beginning</verse>
+				<verse osisID="Gen.1.2">And the earth</verse>
+			</chapter></div>`,
+			expected: []string{"genesis 1:1 In the beginning", "genesis 1:2 And the earth"},
+		},
+		{
+			name: "should read milestone verses across paragraphs",
+			body: `<div type="book" osisID="Gen"><chapter sID="Gen.1"/>
+				<p><verse sID="Gen.1.1" osisID="Gen.1.1"/>In the beginning<verse eID="Gen.1.1"/>
+				<verse sID="Gen.1.2" osisID="Gen.1.2"/>And the earth</p>
+				<p>was without form<verse eID="Gen.1.2"/></p>
+			<chapter eID="Gen.1"/></div>`,
+			expected: []string{"genesis 1:1 In the beginning", "genesis 1:2 And the earth was without form"},
+		},
+		{
+			name: "should keep merged verses as a bridge",
+			body: `<div type="book" osisID="Rom"><chapter osisID="Rom.3">
+				<verse osisID="Rom.3.25 Rom.3.26">Whom God hath set forth</verse>
+			</chapter></div>`,
+			expected: []string{"romanos 3:25-26 Whom God hath set forth"},
+		},
+		{
+			name: "should turn titles into research and drop notes",
+			body: `<div type="book" osisID="John"><chapter osisID="John.3">
+				<title type="section">Nicodemus</title>
+				<verse osisID="John.3.1">There was a man<note type="study">A ruler</note> of the Pharisees</verse>
+				<title>The new birth</title>
+				<verse osisID="John.3.3">Jesus answered <rdg>said</rdg>and said</verse>
+				<title type="acrostic">Aleph</title>
+			</chapter></div>`,
+			expected: []string{"juan 3: Nicodemus", "juan 3:1 There was a man of the Pharisees", "juan 3:3 [The new birth] Jesus answered and said"},
+		},
+		{
+			name: "should skip books it does not know",
+			body: `<div type="book" osisID="Tob"><chapter osisID="Tob.1"><verse osisID="Tob.1.1">The book of the words of Tobit</verse></chapter></div>
+				<div type="book" osisID="Jude"><chapter osisID="Jude.1"><verse osisID="KJV:Jude.1.1">Jude</verse></chapter></div>`,
+			expected: []string{"judas 1:1 Jude"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseOSIS(strings.NewReader(osisDocument(tt.body)), bibleimport.Metadata{})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should read the version from the header", func(t *testing.T) {
+		bible, err := bibleimport.ParseOSIS(strings.NewReader(osisDocument(`<div type="book" osisID="Gen"><verse osisID="Gen.1.1">In the beginning</verse></div>`)), bibleimport.Metadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "King James Version", bible.Name)
+		assert.Equal(t, "KJV", bible.Abbreviation)
+		assert.Equal(t, "en", bible.Language)
+		assert.Equal(t, "Public domain", bible.License)
+	})
+
+	t.Run("should fail on broken xml", func(t *testing.T) {
+		_, err := bibleimport.ParseOSIS(strings.NewReader(`<osis><osisText><verse osisID="Gen.1.1">`), bibleimport.Metadata{Name: "Prueba"})
+
+		assert.ErrorContains(t, err, "invalid osis xml")
+	})
+
+	t.Run("should fail without known books", func(t *testing.T) {
+		_, err := bibleimport.ParseOSIS(strings.NewReader(osisDocument("")), bibleimport.Metadata{})
+
+		assert.ErrorContains(t, err, "no known books found")
+	})
+}
+
+// osisDocument wraps body in the header of a King James Version document.
+func osisDocument(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<osis xmlns="http://www.bibletechnologies.net/2003/OSIS/namespace">
+	<osisText osisIDWork="KJV" xml:lang="en">
+		<header>
+			<work osisWork="KJV">
+				<title>King James Version</title>
+				<rights>Public domain</rights>
+			</work>
+		</header>
+		` + body + `
+	</osisText>
+</osis>`
+}
@@ -0,0 +1,249 @@
+package bibleimport
+
+import (
+	"fmt"
+	"services/api/domain/consts"
+	"strconv"
+	"strings"
+)
+
+// usfmHeadings are paragraph markers whose line is a section heading.
+var usfmHeadings = map[string]bool{
+	"s": true, "s1": true, "s2": true, "s3": true, "s4": true,
+	"ms": true, "ms1": true, "ms2": true, "ms3": true,
+	"d": true, "sp": true,
+}
+
+// usfmLineSkips are paragraph markers whose line carries book metadata or
+// references rather than scripture text.
+var usfmLineSkips = map[string]bool{
+	"id": true, "ide": true, "h": true, "rem": true, "sts": true, "usfm": true,
+	"toc1": true, "toc2": true, "toc3": true, "toca1": true, "toca2": true, "toca3": true,
+	"mt": true, "mt1": true, "mt2": true, "mt3": true, "mt4": true,
+	"mte": true, "mte1": true, "mte2": true,
+	"imt": true, "imt1": true, "imt2": true, "is": true, "is1": true, "is2": true,
+	"ip": true, "ipi": true, "im": true, "io": true, "io1": true, "io2": true, "iot": true,
+	"r": true, "mr": true, "sr": true, "cl": true, "cd": true,
+}
+
+// usfmNotes are character spans dropped together with their content.
+var usfmNotes = map[string]bool{
+	"f": true, "fe": true, "x": true, "fig": true, "ef": true, "ex": true,
+	"va": true, "vp": true, "ca": true, "cp": true, "rq": true,
+}
+
+type usfmParser struct {
+	b       *builder
+	book    string
+	chapter int
+	verse   int
+	last    int
+	text    strings.Builder
+}
+
+// parseUSFMBook reads one USFM book into the builder. \s headings become
+// research, footnotes and cross references are dropped and word attributes
+// (\w gracious|strong="H2603"\w*) are reduced to the word itself.
+func parseUSFMBook(b *builder, content string) error {
+	p := &usfmParser{b: b}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var (
+		lineSkip  bool
+		heading   *strings.Builder
+		note      string
+		attribute bool
+	)
+
+	endLine := func() {
+		lineSkip = false
+		if heading != nil {
+			p.b.addHeading(heading.String())
+			heading = nil
+		}
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		if c == '\n' {
+			endLine()
+			attribute = false
+			p.write(" ")
+			i++
+			continue
+		}
+		if c != '\\' {
+			end := strings.IndexAny(content[i:], "\\\n")
+			if end < 0 {
+				end = len(content) - i
+			}
+			chunk := content[i : i+end]
+			i += end
+			switch {
+			case note != "" || lineSkip:
+			case heading != nil:
+				heading.WriteString(chunk)
+			default:
+				if attribute {
+					continue
+				}
+				if bar := strings.IndexByte(chunk, '|'); bar >= 0 {
+					chunk = chunk[:bar]
+					attribute = true
+				}
+				p.write(chunk)
+			}
+			continue
+		}
+
+		marker, closing, next := readUSFMMarker(content, i)
+		i = next
+		attribute = false
+
+		if note != "" {
+			if closing && marker == note {
+				note = ""
+			}
+			continue
+		}
+		if closing {
+			continue
+		}
+		if usfmNotes[marker] {
+			note = marker
+			continue
+		}
+
+		switch {
+		case marker == "id":
+			code, rest := readUSFMWord(content, i)
+			i = rest
+			if err := p.flush(); err != nil {
+				return err
+			}
+			p.book = consts.USFMBooks[strings.ToUpper(code)]
+			p.chapter = 0
+			lineSkip = true
+		case marker == "c":
+			number, rest := readUSFMWord(content, i)
+			i = rest
+			if err := p.flush(); err != nil {
+				return err
+			}
+			chapter, err := strconv.Atoi(number)
+			if err != nil {
+				return fmt.Errorf("invalid chapter %q", number)
+			}
+			p.chapter = chapter
+		case marker == "v":
+			number, rest := readUSFMWord(content, i)
+			i = rest
+			if err := p.flush(); err != nil {
+				return err
+			}
+			first, last, err := parseVerseBridge(number)
+			if err != nil {
+				return err
+			}
+			p.verse, p.last = first, last
+		case usfmHeadings[marker]:
+			endLine()
+			if err := p.flushText(); err != nil {
+				return err
+			}
+			heading = &strings.Builder{}
+		case usfmLineSkips[marker]:
+			lineSkip = true
+		default:
+			p.write(" ")
+		}
+	}
+
+	endLine()
+	return p.flush()
+}
+
+func (p *usfmParser) write(text string) {
+	if p.verse > 0 {
+		p.text.WriteString(text)
+	}
+}
+
+func (p *usfmParser) flush() error {
+	err := p.flushText()
+	p.verse, p.last = 0, 0
+	return err
+}
+
+// flushText stores the text read so far but keeps the verse open, so a
+// heading in the middle of a verse does not swallow the rest of it.
+func (p *usfmParser) flushText() error {
+	defer p.text.Reset()
+	if p.book == "" || p.chapter == 0 || p.verse == 0 {
+		return nil
+	}
+	return p.b.addVerse(p.book, p.chapter, p.verse, p.last, p.text.String())
+}
+
+// readUSFMMarker reads the marker starting at content[start] ('\'). Nested
+// character markers (\+w) are treated like their plain form, and the single
+// space that terminates an opening marker is consumed.
+func readUSFMMarker(content string, start int) (string, bool, int) {
+	i := start + 1
+	if i < len(content) && content[i] == '+' {
+		i++
+	}
+	nameStart := i
+	for i < len(content) && (isASCIILetter(content[i]) || isASCIIDigit(content[i])) {
+		i++
+	}
+	marker := content[nameStart:i]
+	if i < len(content) && content[i] == '*' {
+		return marker, true, i + 1
+	}
+	if i < len(content) && content[i] == ' ' {
+		i++
+	}
+	return marker, false, i
+}
+
+func readUSFMWord(content string, start int) (string, int) {
+	i := start
+	for i < len(content) && content[i] == ' ' {
+		i++
+	}
+	wordStart := i
+	for i < len(content) && content[i] != ' ' && content[i] != '\n' && content[i] != '\\' {
+		i++
+	}
+	return content[wordStart:i], i
+}
+
+// parseVerseBridge reads verse numbers such as "3", "3-4" or "3a".
+func parseVerseBridge(number string) (int, int, error) {
+	first, last, bridged := strings.Cut(number, "-")
+	start, err := strconv.Atoi(strings.TrimRightFunc(first, isVerseSuffix))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid verse %q", number)
+	}
+	if !bridged {
+		return start, 0, nil
+	}
+	end, err := strconv.Atoi(strings.TrimRightFunc(last, isVerseSuffix))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid verse %q", number)
+	}
+	return start, end, nil
+}
+
+func isVerseSuffix(r rune) bool {
+	return r < '0' || r > '9'
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,83 @@
+package bibleimport_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestParseUSFM(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "should read verses across lines and paragraphs",
+			content:  "\\id GEN World English Bible\n\\h Genesis\n\\mt1 Genesis\n\\c 1\n\\p\n\\v 1 In the beginning\n\\v 2 The earth\n\\q1 was formless\r\n\\c 2\n\\p \\v 1 Thus the heavens",
+			expected: []string{"genesis 1:1 In the beginning", "genesis 1:2 The earth was formless", "genesis 2:1 Thus the heavens"},
+		},
+		{
+			name:     "should keep a bridge under its first verse",
+			content:  "\\id ROM\n\\c 3\n\\v 24 Being justified freely\n\\v 25-26 Whom God set forth\n\\v 27a Where is boasting",
+			expected: []string{"romanos 3:24 Being justified freely", "romanos 3:25-26 Whom God set forth", "romanos 3:27 Where is boasting"},
+		},
+		{
+			name:     "should turn headings into research",
+			content:  "\\id JHN\n\\c 3\n\\s1 Nicodemus\n\\r (Juan 7:50)\n\\p\n\\v 1 There was a man\n\\v 2 He came to Jesus\n\\s1 The new birth\n\\p\n\\v 3 Jesus answered",
+			expected: []string{"juan 3: Nicodemus", "juan 3:1 There was a man", "juan 3:2 He came to Jesus", "juan 3:3 [The new birth] Jesus answered"},
+		},
+		{
+			name:     "should keep a verse going after a heading in its middle",
+			content:  "\\id PSA\n\\c 23\n\\d A Psalm of David.\n\\q1\n\\v 1 The Lord is my shepherd;\n\\s Trust\n\\q2 I shall not want.",
+			expected: []string{"salmos 23: A Psalm of David.", "salmos 23:1 [Trust] The Lord is my shepherd; I shall not want."},
+		},
+		{
+			name:     "should drop footnotes and cross references",
+			content:  "\\id JHN\n\\c 3\n\\p\n\\v 16 For God so loved the world,\\f + \\fr 3:16 \\ft Or only\\f* that he gave\\x - \\xo 3:16 \\xt Rom 5:8\\x* his one and only Son\\va 16a\\va*.",
+			expected: []string{"juan 3:16 For God so loved the world, that he gave his one and only Son."},
+		},
+		{
+			name:     "should reduce word attributes to the word",
+			content:  "\\id JHN\n\\c 11\n\\p\n\\v 35 \\w Jesus|strong=\"G2424\"\\w* \\+w wept|strong=\"G1145\"\\+w*.",
+			expected: []string{"juan 11:35 Jesus wept."},
+		},
+		{
+			name:     "should skip chapters and verses without text",
+			content:  "\\id MAT\n\\c 17\n\\p\n\\v 20 Because of your unbelief\n\\v 21\n\\v 22 While they stayed\n\\c 18\n\\c 19\n\\v 1 When Jesus had finished",
+			expected: []string{"mateo 17:20 Because of your unbelief", "mateo 17:22 While they stayed", "mateo 19:1 When Jesus had finished"},
+		},
+		{
+			name:     "should skip books it does not know",
+			content:  "\\id TOB\n\\c 1\n\\v 1 The book of Tobit\n\\id JUD\n\\c 1\n\\v 1 Jude",
+			expected: []string{"judas 1:1 Jude"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseUSFM(strings.NewReader(tt.content), bibleimport.Metadata{Name: "WEB"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should fail on numbers it cannot read", func(t *testing.T) {
+		bad := []struct {
+			content  string
+			expected string
+		}{
+			{content: "\\id JHN\n\\c tres\n\\v 16 Porque", expected: `invalid chapter "tres"`},
+			{content: "\\id JHN\n\\c\n\\v 16 Porque", expected: `invalid chapter ""`},
+			{content: "\\id JHN\n\\c 3\n\\v 16-x Porque", expected: `invalid verse "16-x"`},
+			{content: "\\id JHN\n\\c 3\n\\v\n", expected: `invalid verse ""`},
+		}
+		for _, tt := range bad {
+			_, err := bibleimport.ParseUSFM(strings.NewReader(tt.content), bibleimport.Metadata{Name: "WEB"})
+
+			assert.ErrorContains(t, err, tt.expected, tt.content)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package bibleimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"services/api/domain/consts"
+	"strconv"
+	"strings"
+)
+
+// parseUSXBook reads one USX book into the builder. Verses are milestones
+// that run until the next verse or chapter; para styles follow the USFM
+// marker names.
+func parseUSXBook(b *builder, r io.Reader) error {
+	decoder := xml.NewDecoder(r)
+	p := &usfmParser{b: b}
+
+	var (
+		skipDepth int
+		heading   *strings.Builder
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid usx xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			switch t.Name.Local {
+			case "book":
+				if err := p.flush(); err != nil {
+					return err
+				}
+				p.book = consts.USFMBooks[strings.ToUpper(attr(t, "code"))]
+				p.chapter = 0
+				skipDepth = 1
+			case "chapter":
+				number := attr(t, "number")
+				if number == "" {
+					continue
+				}
+				if err := p.flush(); err != nil {
+					return err
+				}
+				chapter, err := strconv.Atoi(number)
+				if err != nil {
+					return fmt.Errorf("invalid chapter %q", number)
+				}
+				p.chapter = chapter
+			case "verse":
+				number := attr(t, "number")
+				if number == "" {
+					continue
+				}
+				if err := p.flush(); err != nil {
+					return err
+				}
+				first, last, err := parseVerseBridge(number)
+				if err != nil {
+					return err
+				}
+				p.verse, p.last = first, last
+			case "note", "figure", "sidebar":
+				skipDepth = 1
+			case "char":
+				if usfmNotes[attr(t, "style")] {
+					skipDepth = 1
+				}
+			case "para":
+				style := attr(t, "style")
+				switch {
+				case usfmHeadings[style]:
+					if err := p.flushText(); err != nil {
+						return err
+					}
+					heading = &strings.Builder{}
+				case usfmLineSkips[style]:
+					skipDepth = 1
+				default:
+					p.write(" ")
+				}
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if t.Name.Local == "para" {
+				if heading != nil {
+					b.addHeading(heading.String())
+					heading = nil
+				}
+				p.write(" ")
+			}
+		case xml.CharData:
+			if skipDepth > 0 {
+				continue
+			}
+			if heading != nil {
+				heading.Write(t)
+				continue
+			}
+			p.write(string(t))
+		}
+	}
+
+	return p.flush()
+}
@@ -0,0 +1,84 @@
+package bibleimport_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestParseUSX(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "should read verses that run to the next one",
+			body: `<book code="GEN" style="id">World English Bible</book>
+				<chapter number="1" style="c"/>
+				<para style="p"><verse number="1" style="v"/>In the beginning <verse number="2" style="v"/>The earth</para>
+				<para style="q1">was formless</para>`,
+			expected: []string{"genesis 1:1 In the beginning", "genesis 1:2 The earth was formless"},
+		},
+		{
+			name: "should read verses closed by an eid",
+			body: `<book code="GEN" style="id"/>
+				<chapter number="1" style="c" sid="GEN 1"/>
+				<para style="p"><verse number="1" style="v" sid="GEN 1:1"/>In the beginning<verse eid="GEN 1:1"/>
+				<verse number="2" style="v" sid="GEN 1:2"/>The earth</para>
+				<para style="q1">was formless<verse eid="GEN 1:2"/></para>
+				<chapter eid="GEN 1"/>
+				<chapter number="2" style="c" sid="GEN 2"/>
+				<para style="p"><verse number="1" style="v" sid="GEN 2:1"/>Thus the heavens<verse eid="GEN 2:1"/></para>
+				<chapter eid="GEN 2"/>`,
+			expected: []string{"genesis 1:1 In the beginning", "genesis 1:2 The earth was formless", "genesis 2:1 Thus the heavens"},
+		},
+		{
+			name: "should turn section paragraphs into research",
+			body: `<book code="JHN" style="id"/>
+				<chapter number="3" style="c"/>
+				<para style="s1">Nicodemus</para>
+				<para style="r">(Juan 7:50)</para>
+				<para style="p"><verse number="1" style="v"/>There was a man</para>
+				<para style="s1">The new birth</para>
+				<para style="p"><verse number="3" style="v"/>Jesus answered</para>`,
+			expected: []string{"juan 3: Nicodemus", "juan 3:1 There was a man", "juan 3:3 [The new birth] Jesus answered"},
+		},
+		{
+			name: "should drop notes and keep words",
+			body: `<book code="JHN" style="id"/>
+				<chapter number="3" style="c"/>
+				<para style="p"><verse number="16" style="v"/>For God so <char style="w" strong="G25">loved</char> the world<note caller="+" style="f"><char style="fr">3:16 </char><char style="ft">Or only</char></note>, that he gave<char style="va">16a</char></para>`,
+			expected: []string{"juan 3:16 For God so loved the world, that he gave"},
+		},
+		{
+			name: "should keep a bridge",
+			body: `<book code="ROM" style="id"/>
+				<chapter number="3" style="c"/>
+				<para style="p"><verse number="25-26" style="v"/>Whom God set forth</para>`,
+			expected: []string{"romanos 3:25-26 Whom God set forth"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseUSX(strings.NewReader(`<usx version="3.0">`+tt.body+`</usx>`), bibleimport.Metadata{Name: "WEB"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should fail on a chapter number it cannot read", func(t *testing.T) {
+		_, err := bibleimport.ParseUSX(strings.NewReader(`<usx><book code="GEN"/><chapter number="uno"/></usx>`), bibleimport.Metadata{Name: "WEB"})
+
+		assert.ErrorContains(t, err, `invalid chapter "uno"`)
+	})
+
+	t.Run("should fail on broken xml", func(t *testing.T) {
+		_, err := bibleimport.ParseUSX(strings.NewReader(`<usx><book code="GEN">`), bibleimport.Metadata{Name: "WEB"})
+
+		assert.ErrorContains(t, err, "invalid usx xml")
+	})
+}
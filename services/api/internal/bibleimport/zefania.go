@@ -0,0 +1,148 @@
+package bibleimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+)
+
+type zefaniaImporter struct{}
+
+func (zefaniaImporter) Format() string       { return FormatZefania }
+func (zefaniaImporter) Extensions() []string { return nil }
+func (zefaniaImporter) Sniff(head []byte) bool {
+	return strings.EqualFold(xmlRoot(head), "XMLBIBLE")
+}
+func (zefaniaImporter) Parse(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	return ParseZefania(r, meta)
+}
+
+// ParseZefania reads a Zefania XML bible. Books are identified by their
+// bnumber (1 = Genesis ... 66 = Revelation); deuterocanonical numbers are
+// skipped. CAPTION elements become research headings.
+func ParseZefania(r io.Reader, meta Metadata) (*entities.BibleImport, error) {
+	decoder := xml.NewDecoder(r)
+
+	var (
+		b           builder
+		book        string
+		chapter     int
+		verse       int
+		last        int
+		text        *strings.Builder
+		caption     *strings.Builder
+		information string
+		field       *strings.Builder
+		skipDepth   int
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid zefania xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			name := strings.ToUpper(t.Name.Local)
+			switch name {
+			case "XMLBIBLE":
+				b.bible.Name = attr(t, "biblename")
+			case "INFORMATION":
+				information = name
+			case "TITLE", "IDENTIFIER", "LANGUAGE", "RIGHTS":
+				if information != "" {
+					information = name
+					field = &strings.Builder{}
+				}
+			case "BIBLEBOOK":
+				book = ""
+				number, err := strconv.Atoi(attr(t, "bnumber"))
+				if err == nil && number >= 1 && number <= len(consts.BookOrder) {
+					book = consts.BookOrder[number-1]
+				}
+			case "CHAPTER":
+				chapter, _ = strconv.Atoi(attr(t, "cnumber"))
+			case "CAPTION":
+				caption = &strings.Builder{}
+			case "VERS":
+				verse, last, err = parseVerseBridge(attr(t, "vnumber"))
+				if err != nil {
+					return nil, err
+				}
+				text = &strings.Builder{}
+			case "NOTE", "XREF", "MEDIA":
+				skipDepth = 1
+			case "BR":
+				if text != nil {
+					text.WriteByte(' ')
+				}
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			switch strings.ToUpper(t.Name.Local) {
+			case "INFORMATION":
+				information = ""
+			case "TITLE":
+				if field != nil && b.bible.Name == "" {
+					b.bible.Name = collapseSpaces(field.String())
+				}
+				field = nil
+			case "IDENTIFIER":
+				if field != nil {
+					b.bible.Abbreviation = collapseSpaces(field.String())
+				}
+				field = nil
+			case "LANGUAGE":
+				if field != nil {
+					b.bible.Language = collapseSpaces(field.String())
+				}
+				field = nil
+			case "RIGHTS":
+				if field != nil {
+					b.bible.License = collapseSpaces(field.String())
+				}
+				field = nil
+			case "CAPTION":
+				if caption != nil {
+					b.addHeading(caption.String())
+				}
+				caption = nil
+			case "VERS":
+				if text != nil && book != "" && chapter > 0 {
+					if err := b.addVerse(book, chapter, verse, last, text.String()); err != nil {
+						return nil, err
+					}
+				}
+				text = nil
+			}
+		case xml.CharData:
+			switch {
+			case skipDepth > 0:
+			case field != nil:
+				field.Write(t)
+			case caption != nil:
+				caption.Write(t)
+			case text != nil:
+				text.Write(t)
+			}
+		}
+	}
+
+	return b.finish(meta)
+}
@@ -0,0 +1,82 @@
+package bibleimport_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/bibleimport"
+	"strings"
+	"testing"
+)
+
+func TestParseZefania(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "should read books by number",
+			body:     `<BIBLEBOOK bnumber="43"><CHAPTER cnumber="3"><VERS vnumber="16">Porque de tal manera</VERS><VERS vnumber="17">Porque no envió Dios</VERS></CHAPTER></BIBLEBOOK>`,
+			expected: []string{"juan 3:16 Porque de tal manera", "juan 3:17 Porque no envió Dios"},
+		},
+		{
+			name:     "should turn captions into research",
+			body:     `<BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><CAPTION>La creación</CAPTION><VERS vnumber="1">En el principio</VERS><CAPTION>El día</CAPTION><VERS vnumber="3">Y dijo Dios</VERS></CHAPTER></BIBLEBOOK>`,
+			expected: []string{"genesis 1: La creación", "genesis 1:1 En el principio", "genesis 1:3 [El día] Y dijo Dios"},
+		},
+		{
+			name:     "should drop notes and break lines",
+			body:     `<BIBLEBOOK bnumber="19"><CHAPTER cnumber="23"><VERS vnumber="1">Jehová es mi pastor;<NOTE>Heb. pastorea</NOTE><BR/>nada me faltará.<XREF fscope="Jn 10:11"/></VERS></CHAPTER></BIBLEBOOK>`,
+			expected: []string{"salmos 23:1 Jehová es mi pastor; nada me faltará."},
+		},
+		{
+			name:     "should keep a bridge",
+			body:     `<BIBLEBOOK bnumber="45"><CHAPTER cnumber="3"><VERS vnumber="25-26">A quien Dios puso</VERS></CHAPTER></BIBLEBOOK>`,
+			expected: []string{"romanos 3:25-26 A quien Dios puso"},
+		},
+		{
+			name:     "should skip deuterocanonical books",
+			body:     `<BIBLEBOOK bnumber="67"><CHAPTER cnumber="1"><VERS vnumber="1">Tobías</VERS></CHAPTER></BIBLEBOOK><BIBLEBOOK bnumber="65"><CHAPTER cnumber="1"><VERS vnumber="1">Judas</VERS></CHAPTER></BIBLEBOOK>`,
+			expected: []string{"judas 1:1 Judas"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bible, err := bibleimport.ParseZefania(strings.NewReader(zefaniaDocument(tt.body)), bibleimport.Metadata{})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, describeBible(bible))
+		})
+	}
+
+	t.Run("should read the version from the information", func(t *testing.T) {
+		bible, err := bibleimport.ParseZefania(strings.NewReader(zefaniaDocument(`<BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><VERS vnumber="1">En el principio</VERS></CHAPTER></BIBLEBOOK>`)), bibleimport.Metadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reina Valera 1909", bible.Name)
+		assert.Equal(t, "RV1909", bible.Abbreviation)
+		assert.Equal(t, "es", bible.Language)
+		assert.Equal(t, "Dominio público", bible.License)
+	})
+
+	t.Run("should fail on a verse number it cannot read", func(t *testing.T) {
+		_, err := bibleimport.ParseZefania(strings.NewReader(zefaniaDocument(`<BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><VERS vnumber="uno">En el principio</VERS></CHAPTER></BIBLEBOOK>`)), bibleimport.Metadata{})
+
+		assert.ErrorContains(t, err, `invalid verse "uno"`)
+	})
+}
+
+// zefaniaDocument wraps body in the information of a Reina Valera 1909
+// document.
+func zefaniaDocument(body string) string {
+	return `<?xml version="1.0" encoding="utf-8"?>
+<XMLBIBLE biblename="">
+	<INFORMATION>
+		<title>Reina Valera 1909</title>
+		<identifier>RV1909</identifier>
+		<language>es</language>
+		<rights>Dominio público</rights>
+	</INFORMATION>
+	` + body + `
+</XMLBIBLE>`
+}
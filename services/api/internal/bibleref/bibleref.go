@@ -0,0 +1,256 @@
+package bibleref
+
+import (
+	"fmt"
+	"regexp"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Error reports a reference that cannot be read or that points past the end
+// of a book or chapter.
+type Error struct {
+	Input  string
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%q: %s", e.Input, e.Reason)
+}
+
+// singleChapterBooks are cited by verse alone: "Judas 3" is Judas 1:3.
+var singleChapterBooks = map[string]bool{
+	"abdias": true, "filemon": true, "2-juan": true, "3-juan": true, "judas": true,
+}
+
+// specPattern matches "3", "3-4", "3:16", "3:16-18" and "3:16-4:2" once
+// spaces are removed. A dot is accepted in place of the colon and partial
+// verse letters ("3:16a") are ignored.
+var specPattern = regexp.MustCompile(`^(\d+)(?:[:.](\d+)[abc]?)?(?:-(\d+)[abc]?(?:[:.](\d+)[abc]?)?)?$`)
+
+var dashReplacer = strings.NewReplacer("–", "-", "—", "-", "‒", "-")
+
+// Parse reads references such as "Jn 3:16-18; Rom 8:28, 31" or "1 Co 13".
+// Segments are separated by ";" and may omit the book to reuse the previous
+// one; after a verse, comma separated numbers are more verses of the same
+// chapter. Chapter-only ranges leave StartVerse and EndVerse at zero until
+// Check fills them in.
+func Parse(input string) ([]entities.ReferenceRange, error) {
+	var (
+		ranges []entities.ReferenceRange
+		book   string
+	)
+	for _, segment := range strings.Split(dashReplacer.Replace(input), ";") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+		name, rest := splitBook(segment)
+		if name != "" {
+			slug, err := LookupBook(name)
+			if err != nil {
+				return nil, &Error{Input: segment, Reason: err.Error()}
+			}
+			book = slug
+		} else if book == "" {
+			return nil, &Error{Input: segment, Reason: "missing book name"}
+		}
+
+		parsed, err := parseSpecs(book, rest)
+		if err != nil {
+			return nil, &Error{Input: segment, Reason: err.Error()}
+		}
+		ranges = append(ranges, parsed...)
+	}
+	if len(ranges) == 0 {
+		return nil, &Error{Input: input, Reason: "empty reference"}
+	}
+	return ranges, nil
+}
+
+// splitBook cuts the segment at the first digit that follows a letter, so
+// the ordinal of "1 Co 13" stays with the book name.
+func splitBook(segment string) (string, string) {
+	seenLetter := false
+	for i, r := range segment {
+		switch {
+		case unicode.IsLetter(r):
+			seenLetter = true
+		case unicode.IsDigit(r) && seenLetter:
+			return strings.TrimSpace(segment[:i]), segment[i:]
+		}
+	}
+	if seenLetter {
+		return strings.TrimSpace(segment), ""
+	}
+	return "", segment
+}
+
+func parseSpecs(book string, rest string) ([]entities.ReferenceRange, error) {
+	single := singleChapterBooks[book]
+	if strings.TrimSpace(rest) == "" {
+		if !single {
+			return nil, fmt.Errorf("missing chapter")
+		}
+		r := newRange(book, 1, 0, 1, 0)
+		r.Reference = Format(r)
+		return []entities.ReferenceRange{r}, nil
+	}
+
+	// chapter is the chapter the previous part ended in when it named a
+	// verse, so "3:16, 18" reads 18 as a verse of chapter 3.
+	chapter := 0
+	if single {
+		chapter = 1
+	}
+
+	var ranges []entities.ReferenceRange
+	for _, part := range strings.Split(rest, ",") {
+		part = strings.ToLower(strings.Join(strings.Fields(part), ""))
+		match := specPattern.FindStringSubmatch(part)
+		if match == nil {
+			return nil, fmt.Errorf("cannot read %q", part)
+		}
+		n := make([]int, len(match))
+		for i := 1; i < len(match); i++ {
+			if match[i] != "" {
+				n[i], _ = strconv.Atoi(match[i])
+			}
+		}
+		hasVerse, hasEnd, hasEndVerse := match[2] != "", match[3] != "", match[4] != ""
+
+		var r entities.ReferenceRange
+		switch {
+		case hasVerse:
+			r = newRange(book, n[1], n[2], n[1], n[2])
+			if hasEndVerse {
+				r.EndChapter, r.EndVerse = n[3], n[4]
+			} else if hasEnd {
+				r.EndVerse = n[3]
+			}
+		case chapter > 0:
+			r = newRange(book, chapter, n[1], chapter, n[1])
+			if hasEndVerse {
+				r.EndChapter, r.EndVerse = n[3], n[4]
+			} else if hasEnd {
+				r.EndVerse = n[3]
+			}
+		default:
+			r = newRange(book, n[1], 0, n[1], 0)
+			if hasEndVerse {
+				r.StartVerse = 1
+				r.EndChapter, r.EndVerse = n[3], n[4]
+			} else if hasEnd {
+				r.EndChapter = n[3]
+			}
+		}
+		r.Reference = Format(r)
+		if r.EndVerse > 0 {
+			chapter = r.EndChapter
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func newRange(book string, startChapter int, startVerse int, endChapter int, endVerse int) entities.ReferenceRange {
+	return entities.ReferenceRange{
+		Book:         book,
+		BookName:     consts.Books[book],
+		StartChapter: startChapter,
+		StartVerse:   startVerse,
+		EndChapter:   endChapter,
+		EndVerse:     endVerse,
+	}
+}
+
+// Check validates the range against the verse count of each chapter of its
+// book in one version and fills in the verses of chapter-only ranges.
+func Check(r *entities.ReferenceRange, sizes map[int]int) error {
+	fail := func(format string, args ...interface{}) error {
+		return &Error{Input: r.Reference, Reason: fmt.Sprintf(format, args...)}
+	}
+	if len(sizes) == 0 {
+		return fail("%s is not available in this version", r.BookName)
+	}
+	for _, chapter := range []int{r.StartChapter, r.EndChapter} {
+		if _, ok := sizes[chapter]; !ok {
+			return fail("%s has no chapter %d (it has %d)", r.BookName, chapter, len(sizes))
+		}
+	}
+	if r.EndChapter < r.StartChapter {
+		return fail("range ends before it starts")
+	}
+
+	if r.StartVerse == 0 {
+		r.StartVerse = 1
+	}
+	if r.EndVerse == 0 {
+		r.EndVerse = sizes[r.EndChapter]
+	}
+	if r.StartVerse > sizes[r.StartChapter] {
+		return fail("%s %d has no verse %d (it has %d)", r.BookName, r.StartChapter, r.StartVerse, sizes[r.StartChapter])
+	}
+	if r.EndVerse > sizes[r.EndChapter] {
+		return fail("%s %d has no verse %d (it has %d)", r.BookName, r.EndChapter, r.EndVerse, sizes[r.EndChapter])
+	}
+	if r.EndChapter == r.StartChapter && r.EndVerse < r.StartVerse {
+		return fail("range ends before it starts")
+	}
+	return nil
+}
+
+// Format renders a range the way it is shown on screen: "Juan 3",
+// "Juan 3:16", "Juan 3:16-18" or "Romanos 8:28-9:5".
+func Format(r entities.ReferenceRange) string {
+	return consts.Books[r.Book] + " " + formatSpan(r, false)
+}
+
+// FormatRanges joins parsed ranges into one display reference, dropping the
+// repeated book name and chapter: "Romanos 8:28, 31; 9:1-5; Juan 3:16".
+// It expects ranges as returned by Parse, before Check fills in verses.
+func FormatRanges(ranges []entities.ReferenceRange) string {
+	var out strings.Builder
+	for i, r := range ranges {
+		if i == 0 {
+			out.WriteString(Format(r))
+			continue
+		}
+		prev := ranges[i-1]
+		switch {
+		case prev.Book != r.Book:
+			out.WriteString("; " + Format(r))
+		case prev.EndVerse > 0 && r.StartVerse > 0 && prev.EndChapter == r.StartChapter && r.StartChapter == r.EndChapter:
+			out.WriteString(", " + formatSpan(r, true))
+		default:
+			out.WriteString("; " + formatSpan(r, false))
+		}
+	}
+	return out.String()
+}
+
+// formatSpan renders the chapter and verse part of a range. With
+// sameChapter the chapter is left out, for verses listed after a comma.
+func formatSpan(r entities.ReferenceRange, sameChapter bool) string {
+	switch {
+	case r.StartVerse == 0 && r.EndVerse == 0:
+		if r.EndChapter == r.StartChapter {
+			return strconv.Itoa(r.StartChapter)
+		}
+		return fmt.Sprintf("%d-%d", r.StartChapter, r.EndChapter)
+	case r.EndChapter == r.StartChapter:
+		verses := strconv.Itoa(r.StartVerse)
+		if r.EndVerse != r.StartVerse {
+			verses = fmt.Sprintf("%d-%d", r.StartVerse, r.EndVerse)
+		}
+		if sameChapter {
+			return verses
+		}
+		return fmt.Sprintf("%d:%s", r.StartChapter, verses)
+	default:
+		return fmt.Sprintf("%d:%d-%d:%d", r.StartChapter, max(r.StartVerse, 1), r.EndChapter, r.EndVerse)
+	}
+}
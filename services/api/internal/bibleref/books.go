@@ -0,0 +1,141 @@
+package bibleref
+
+import (
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strings"
+	"sync"
+)
+
+// ordinals maps the spellings of a numbered book prefix onto its digit.
+var ordinals = map[string]string{
+	"1": "1", "i": "1", "1a": "1", "1ra": "1", "1o": "1", "1ro": "1", "1er": "1", "1st": "1",
+	"primera": "1", "primero": "1", "primer": "1", "first": "1",
+	"2": "2", "ii": "2", "2a": "2", "2da": "2", "2o": "2", "2do": "2", "2nd": "2",
+	"segunda": "2", "segundo": "2", "second": "2",
+	"3": "3", "iii": "3", "3a": "3", "3ra": "3", "3o": "3", "3ro": "3", "3er": "3", "3rd": "3",
+	"tercera": "3", "tercero": "3", "tercer": "3", "third": "3",
+}
+
+var bookNameReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+	"â", "a", "ã", "a", "à", "a", "ê", "e", "ô", "o", "õ", "o", "ç", "c",
+	"º", "o", "ª", "a", ".", " ", "-", " ", "_", " ",
+)
+
+// bookIndex maps every folded name and abbreviation onto its slug. It
+// starts with the built-in names of consts and is rebuilt by SetAliases.
+// builtinIndex keeps the built-in names alone, whose prefixes win over the
+// prefixes of aliases.
+var (
+	bookIndexMu  sync.RWMutex
+	bookIndex    = buildBookIndex(nil)
+	builtinIndex = buildBookIndex(nil)
+)
+
+func buildBookIndex(aliases []entities.BookAlias) map[string]string {
+	index := map[string]string{}
+	for slug, name := range consts.Books {
+		index[bookKey(slug)] = slug
+		index[bookKey(name)] = slug
+		for _, alias := range consts.BookAliases[slug] {
+			index[bookKey(alias)] = slug
+		}
+	}
+	for _, alias := range aliases {
+		key := bookKey(alias.Alias)
+		if _, taken := index[key]; !taken && key != "" {
+			index[key] = alias.Book
+		}
+	}
+	return index
+}
+
+// SetAliases adds aliases, such as the rows of the book_aliases table, to
+// the built-in names. It replaces the aliases set before. An alias never
+// takes over a built-in name.
+func SetAliases(aliases []entities.BookAlias) {
+	index := buildBookIndex(aliases)
+	bookIndexMu.Lock()
+	bookIndex = index
+	bookIndexMu.Unlock()
+}
+
+// ExactBook resolves a name only when it is a known name or alias, without
+// the prefix matching of LookupBook.
+func ExactBook(name string) (string, bool) {
+	bookIndexMu.RLock()
+	defer bookIndexMu.RUnlock()
+	slug, ok := bookIndex[bookKey(name)]
+	return slug, ok
+}
+
+// BookKey folds a name the way the book index compares them, for storing
+// alongside an alias.
+func BookKey(name string) string {
+	return bookKey(name)
+}
+
+// bookKey folds a book name so "1ra. Corintios", "Primera de Corintios" and
+// "1corintios" compare equal.
+func bookKey(name string) string {
+	fields := strings.Fields(bookNameReplacer.Replace(strings.ToLower(name)))
+	if len(fields) > 1 {
+		if digit, ok := ordinals[fields[0]]; ok {
+			fields[0] = digit
+			if len(fields) > 2 && (fields[1] == "de" || fields[1] == "of") {
+				fields = append(fields[:1], fields[2:]...)
+			}
+		}
+	}
+	return strings.Join(fields, "")
+}
+
+// LookupBook resolves a book name or abbreviation to its slug. Names that
+// are not listed are accepted when they are the start of exactly one book's
+// name, so "Filem" or "Apocal" still work. The built-in names are tried
+// before the aliases, so the alias "Tiago" does not make "Ti" ambiguous.
+func LookupBook(name string) (string, error) {
+	key := bookKey(name)
+	if key == "" {
+		return "", fmt.Errorf("missing book name")
+	}
+	bookIndexMu.RLock()
+	defer bookIndexMu.RUnlock()
+	if slug, ok := bookIndex[key]; ok {
+		return slug, nil
+	}
+
+	matches := prefixMatches(builtinIndex, key)
+	if len(matches) == 0 {
+		matches = prefixMatches(bookIndex, key)
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unknown book %q", strings.TrimSpace(name))
+	case 1:
+		for slug := range matches {
+			return slug, nil
+		}
+	}
+
+	names := make([]string, 0, len(matches))
+	for _, slug := range consts.BookOrder {
+		if matches[slug] {
+			names = append(names, consts.Books[slug])
+		}
+	}
+	return "", fmt.Errorf("ambiguous book %q: %s", strings.TrimSpace(name), strings.Join(names, ", "))
+}
+
+// prefixMatches returns the books with a name in index that starts with key.
+func prefixMatches(index map[string]string, key string) map[string]bool {
+	matches := map[string]bool{}
+	for candidate, slug := range index {
+		if strings.HasPrefix(candidate, key) {
+			matches[slug] = true
+		}
+	}
+	return matches
+}
@@ -0,0 +1,119 @@
+package bibleref_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/dbmigrate"
+	"services/api/internal/infrastructure"
+	"services/api/migrations"
+	"services/api/pkg/sqlite"
+	"testing"
+)
+
+func TestLookupBook(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Génesis", expected: "genesis"},
+		{name: "Gn", expected: "genesis"},
+		{name: "Genesis", expected: "genesis"},
+		{name: "Jn", expected: "juan"},
+		{name: "1ra. Corintios", expected: "1-corintios"},
+		{name: "Primera de Juan", expected: "1-juan"},
+		{name: "Hch", expected: "hechos"},
+		{name: "Hc", expected: "hechos"},
+		{name: "Ti", expected: "tito"},
+		{name: "Tit", expected: "tito"},
+		{name: "Sal", expected: "salmos"},
+		{name: "Filem", expected: "filemon"},
+		{name: "Apocal", expected: "apocalipsis"},
+	}
+
+	run := func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				slug, err := bibleref.LookupBook(tt.name)
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, slug)
+			})
+		}
+		t.Run("Jo stays ambiguous", func(t *testing.T) {
+			_, err := bibleref.LookupBook("Jo")
+
+			assert.ErrorContains(t, err, "ambiguous")
+		})
+	}
+
+	t.Run("should resolve the built-in names", run)
+
+	t.Run("should resolve the built-in names the same with the seeded aliases", func(t *testing.T) {
+		useAliases(t, seededAliases(t))
+		run(t)
+	})
+
+	t.Run("should resolve the seeded aliases", func(t *testing.T) {
+		useAliases(t, seededAliases(t))
+		for name, expected := range map[string]string{"Tiago": "santiago", "Tia": "santiago", "João": "juan", "Gênesis": "genesis", "Atos": "hechos"} {
+			slug, err := bibleref.LookupBook(name)
+
+			require.NoError(t, err, name)
+			assert.Equal(t, expected, slug, name)
+		}
+	})
+
+	t.Run("should let a custom alias name a book", func(t *testing.T) {
+		useAliases(t, []entities.BookAlias{{Book: "juan", Alias: "Evangelio de Juan"}})
+
+		slug, err := bibleref.LookupBook("Evangelio de Juan")
+
+		require.NoError(t, err)
+		assert.Equal(t, "juan", slug)
+	})
+
+	t.Run("should not let an alias take over a built-in name", func(t *testing.T) {
+		useAliases(t, []entities.BookAlias{{Book: "job", Alias: "Jn"}})
+
+		slug, err := bibleref.LookupBook("Jn")
+
+		require.NoError(t, err)
+		assert.Equal(t, "juan", slug)
+	})
+}
+
+func TestSeededAliases(t *testing.T) {
+	t.Run("should only seed aliases that name their own book by the built-in names", func(t *testing.T) {
+		for _, alias := range seededAliases(t) {
+			slug, err := bibleref.LookupBook(alias.Alias)
+			if err != nil {
+				continue
+			}
+			assert.Equal(t, alias.Book, slug, "%s (%s)", alias.Alias, alias.Language)
+		}
+	})
+}
+
+// seededAliases reads the aliases the migrations seed.
+func seededAliases(t *testing.T) []entities.BookAlias {
+	t.Helper()
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "bible.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, dbmigrate.Run(context.Background(), db, migrations.FS))
+
+	aliases, err := infrastructure.NewBookAliasRepo(db).ListBookAliases(context.Background(), "")
+	require.NoError(t, err)
+	require.NotEmpty(t, aliases)
+	return aliases
+}
+
+// useAliases sets aliases for the rest of the test and drops them after.
+func useAliases(t *testing.T, aliases []entities.BookAlias) {
+	bibleref.SetAliases(aliases)
+	t.Cleanup(func() { bibleref.SetAliases(nil) })
+}
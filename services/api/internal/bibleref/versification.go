@@ -0,0 +1,33 @@
+package bibleref
+
+import "services/api/domain/entities"
+
+// Versification converts verse numbers between one numbering scheme and the
+// standard one. The zero value is the standard scheme itself.
+type Versification []entities.VersificationMapping
+
+// ToStandard maps a verse numbered in this scheme onto the standard numbering.
+func (v Versification) ToStandard(book string, chapter int, verse int) (int, int) {
+	for _, m := range v {
+		if m.Book == book && inRange(chapter, m.FirstChapter, m.LastChapter) && inRange(verse, m.FirstVerse, m.LastVerse) {
+			return chapter + m.ChapterOffset, verse + m.VerseOffset
+		}
+	}
+	return chapter, verse
+}
+
+// FromStandard maps a verse in the standard numbering onto this scheme, so
+// Salmos 23:1 becomes Salmos 22:1 in a Septuagint numbered bible.
+func (v Versification) FromStandard(book string, chapter int, verse int) (int, int) {
+	for _, m := range v {
+		local, localVerse := chapter-m.ChapterOffset, verse-m.VerseOffset
+		if m.Book == book && inRange(local, m.FirstChapter, m.LastChapter) && inRange(localVerse, m.FirstVerse, m.LastVerse) {
+			return local, localVerse
+		}
+	}
+	return chapter, verse
+}
+
+func inRange(value int, first int, last int) bool {
+	return value >= first && value <= last
+}
@@ -0,0 +1,151 @@
+package crossref
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"strconv"
+	"strings"
+)
+
+// Parse reads a Treasury of Scripture Knowledge export as published by
+// openbible.info, one link per line:
+//
+//	From Verse	To Verse	Votes
+//	Gen.1.1	Prov.8.22-Prov.8.30	59
+//
+// References are OSIS ids; plain references such as "Juan 3:16" are
+// accepted too, and the votes column is optional. Lines that cannot be read
+// and links voted below zero are counted in skipped instead of failing the
+// whole file.
+func Parse(r io.Reader) ([]entities.CrossReference, int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		refs    []entities.CrossReference
+		skipped int
+		line    int
+	)
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if line == 1 && strings.EqualFold(strings.TrimSpace(fields[0]), "From Verse") {
+			continue
+		}
+
+		ref, err := parseLine(fields)
+		if err != nil || ref.Votes < 0 {
+			skipped++
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, skipped, err
+	}
+	if len(refs) == 0 {
+		return nil, skipped, errors.New("no cross references found")
+	}
+	return refs, skipped, nil
+}
+
+func parseLine(fields []string) (entities.CrossReference, error) {
+	if len(fields) < 2 {
+		return entities.CrossReference{}, errors.New("expected a from and a to reference")
+	}
+	from, err := ParseReference(fields[0])
+	if err != nil {
+		return entities.CrossReference{}, err
+	}
+	to, err := ParseReference(fields[1])
+	if err != nil {
+		return entities.CrossReference{}, err
+	}
+
+	ref := entities.CrossReference{
+		Book:    from.Book,
+		Chapter: from.StartChapter,
+		Verse:   from.StartVerse,
+		Target:  to,
+	}
+	if len(fields) > 2 && strings.TrimSpace(fields[2]) != "" {
+		if ref.Votes, err = strconv.Atoi(strings.TrimSpace(fields[2])); err != nil {
+			return entities.CrossReference{}, fmt.Errorf("invalid votes %q", fields[2])
+		}
+	}
+	return ref, nil
+}
+
+// ParseReference reads "Gen.1.1", "Prov.8.22-Prov.8.30" or anything
+// bibleref.Parse understands, keeping only the first range.
+func ParseReference(value string) (entities.ReferenceRange, error) {
+	value = strings.TrimSpace(value)
+	if start, end, isRange := strings.Cut(value, "-"); strings.Count(start, ".") == 2 {
+		first, err := parseOSISVerse(start)
+		if err != nil {
+			return entities.ReferenceRange{}, err
+		}
+		last := first
+		if isRange {
+			if last, err = parseOSISVerse(end); err != nil {
+				return entities.ReferenceRange{}, err
+			}
+			if last.Book != first.Book {
+				return entities.ReferenceRange{}, fmt.Errorf("%q spans two books", value)
+			}
+		}
+		first.EndChapter, first.EndVerse = last.StartChapter, last.StartVerse
+		first.Reference = bibleref.Format(first)
+		return first, nil
+	}
+
+	ranges, err := bibleref.Parse(value)
+	if err != nil {
+		return entities.ReferenceRange{}, err
+	}
+	r := ranges[0]
+	if r.StartVerse == 0 {
+		return entities.ReferenceRange{}, fmt.Errorf("%q has no verse", value)
+	}
+	if r.EndVerse == 0 {
+		r.EndVerse = r.StartVerse
+	}
+	r.Reference = bibleref.Format(r)
+	return r, nil
+}
+
+func parseOSISVerse(value string) (entities.ReferenceRange, error) {
+	parts := strings.Split(strings.TrimSpace(value), ".")
+	if len(parts) != 3 {
+		return entities.ReferenceRange{}, fmt.Errorf("invalid osis reference %q", value)
+	}
+	book, ok := consts.OSISBooks[parts[0]]
+	if !ok {
+		return entities.ReferenceRange{}, fmt.Errorf("unknown osis book %q", parts[0])
+	}
+	chapter, err := strconv.Atoi(parts[1])
+	if err != nil || chapter <= 0 {
+		return entities.ReferenceRange{}, fmt.Errorf("invalid chapter in %q", value)
+	}
+	verse, err := strconv.Atoi(parts[2])
+	if err != nil || verse <= 0 {
+		return entities.ReferenceRange{}, fmt.Errorf("invalid verse in %q", value)
+	}
+	return entities.ReferenceRange{
+		Book:         book,
+		BookName:     consts.Books[book],
+		StartChapter: chapter,
+		StartVerse:   verse,
+		EndChapter:   chapter,
+		EndVerse:     verse,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package crossref_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/crossref"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("should read the links of an openbible.info export", func(t *testing.T) {
+		refs, skipped, err := crossref.Parse(strings.NewReader("From Verse\tTo Verse\tVotes\n" +
+			"Gen.1.1\tProv.8.22-Prov.8.30\t59\n" +
+			"Gen.1.1\tJohn.1.1-John.1.3\t231\n"))
+
+		require.NoError(t, err)
+		assert.Zero(t, skipped)
+		require.Len(t, refs, 2)
+		assert.Equal(t, "genesis", refs[0].Book)
+		assert.Equal(t, 1, refs[0].Chapter)
+		assert.Equal(t, 1, refs[0].Verse)
+		assert.Equal(t, 59, refs[0].Votes)
+		assert.Equal(t, "Proverbios 8:22-30", refs[0].Target.Reference)
+		assert.Equal(t, "Juan 1:1-3", refs[1].Target.Reference)
+	})
+
+	t.Run("should read plain references without votes", func(t *testing.T) {
+		refs, _, err := crossref.Parse(strings.NewReader("Juan 3:16\tRom 5:8\n"))
+
+		require.NoError(t, err)
+		require.Len(t, refs, 1)
+		assert.Equal(t, "juan", refs[0].Book)
+		assert.Equal(t, 16, refs[0].Verse)
+		assert.Zero(t, refs[0].Votes)
+		assert.Equal(t, "Romanos 5:8", refs[0].Target.Reference)
+	})
+
+	t.Run("should count the lines it cannot read and the links voted down", func(t *testing.T) {
+		refs, skipped, err := crossref.Parse(strings.NewReader("# comentario\n" +
+			"Gen.1.1\tJohn.1.1\t10\n" +
+			"Gen.1.1\n" +
+			"Xyz.1.1\tJohn.1.1\t3\n" +
+			"Gen.1.1\tJohn.1.1-Acts.1.1\t3\n" +
+			"Gen.1.1\tHeb.11.3\tmuchos\n" +
+			"Gen.1.2\tPs.104.30\t-2\n"))
+
+		require.NoError(t, err)
+		assert.Len(t, refs, 1)
+		assert.Equal(t, 5, skipped)
+	})
+
+	t.Run("should fail on a file without links", func(t *testing.T) {
+		_, _, err := crossref.Parse(strings.NewReader("From Verse\tTo Verse\tVotes\n"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "Gen.1.1", expected: "Génesis 1:1"},
+		{value: "Ps.23.1-Ps.24.2", expected: "Salmos 23:1-24:2"},
+		{value: "1Cor.13.4-1Cor.13.7", expected: "1 Corintios 13:4-7"},
+		{value: "Jn 3:16-18", expected: "Juan 3:16-18"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			ref, err := crossref.ParseReference(tt.value)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ref.Reference)
+		})
+	}
+
+	t.Run("should reject a reference without a verse", func(t *testing.T) {
+		for _, value := range []string{"Juan 3", "Gen.0.1", "Gen.1.x"} {
+			_, err := crossref.ParseReference(value)
+
+			assert.Error(t, err, value)
+		}
+	})
+}
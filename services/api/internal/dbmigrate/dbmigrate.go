@@ -294,7 +294,7 @@ func extractTableName(line string, prefix string) string {
 }
 
 func execStatements(ctx context.Context, tx *sql.Tx, content string) error {
-	statements := strings.Split(content, ";")
+	statements := splitStatements(content)
 	for _, stmt := range statements {
 		trimmed := strings.TrimSpace(stmt)
 		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
@@ -307,6 +307,90 @@ func execStatements(ctx context.Context, tx *sql.Tx, content string) error {
 	return nil
 }
 
+// splitStatements cuts a migration at the semicolons that end its statements.
+// Semicolons inside quotes or comments do not count, nor do the ones ending
+// the statements of a CREATE TRIGGER body, which runs up to its END. Comments
+// are left out of the statements.
+func splitStatements(content string) []string {
+	statements := make([]string, 0)
+	var statement, code strings.Builder
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			end := closingQuote(content, i)
+			statement.WriteString(content[i:end])
+			code.WriteByte(' ')
+			i = end - 1
+		case strings.HasPrefix(content[i:], "--"):
+			end := strings.IndexByte(content[i:], '\n')
+			if end < 0 {
+				end = len(content) - i
+			}
+			statement.WriteByte('\n')
+			code.WriteByte(' ')
+			i += end
+		case strings.HasPrefix(content[i:], "/*"):
+			end := strings.Index(content[i+2:], "*/")
+			if end < 0 {
+				end = len(content) - i - 2
+			}
+			statement.WriteByte(' ')
+			code.WriteByte(' ')
+			i += end + 3
+		case c == ';' && isOpenTrigger(code.String()):
+			statement.WriteByte(c)
+			code.WriteByte(' ')
+		case c == ';':
+			if trimmed := strings.TrimSpace(statement.String()); trimmed != "" {
+				statements = append(statements, trimmed)
+			}
+			statement.Reset()
+			code.Reset()
+		default:
+			statement.WriteByte(c)
+			code.WriteByte(c)
+		}
+	}
+	if trimmed := strings.TrimSpace(statement.String()); trimmed != "" {
+		statements = append(statements, trimmed)
+	}
+	return statements
+}
+
+// closingQuote returns the index just past the quote that closes the one
+// at content[start]; a doubled quote inside is an escaped one.
+func closingQuote(content string, start int) int {
+	quote := content[start]
+	for i := start + 1; i < len(content); i++ {
+		if content[i] != quote {
+			continue
+		}
+		if i+1 < len(content) && content[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(content)
+}
+
+// isOpenTrigger reports whether code, a statement with its quotes and
+// comments blanked out, is a CREATE TRIGGER whose body has not reached END.
+func isOpenTrigger(code string) bool {
+	words := strings.Fields(strings.ToUpper(code))
+	if len(words) < 2 || words[0] != "CREATE" {
+		return false
+	}
+	words = words[1:]
+	if words[0] == "TEMP" || words[0] == "TEMPORARY" {
+		words = words[1:]
+	}
+	if len(words) == 0 || words[0] != "TRIGGER" {
+		return false
+	}
+	return words[len(words)-1] != "END"
+}
+
 func execInsertFile(tx *sql.Tx, source fs.FS, file string) error {
 	f, err := source.Open(file)
 	if err != nil {
@@ -0,0 +1,65 @@
+package dbmigrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "should cut at every semicolon",
+			content:  "CREATE TABLE a (id INTEGER);\n\nCREATE INDEX idx_a ON a(id);\n",
+			expected: []string{"CREATE TABLE a (id INTEGER)", "CREATE INDEX idx_a ON a(id)"},
+		},
+		{
+			name:     "should keep a statement without a semicolon at the end",
+			content:  "DROP TABLE a;\nDROP TABLE b",
+			expected: []string{"DROP TABLE a", "DROP TABLE b"},
+		},
+		{
+			name: "should keep a trigger body up to its end",
+			content: "CREATE TRIGGER IF NOT EXISTS a_update AFTER UPDATE ON a\nBEGIN\n    DELETE FROM b WHERE id = old.id;\n    INSERT INTO b(id) VALUES (new.id);\nEND;\n" +
+				"create temp trigger a_delete after delete on a begin delete from b; end;\nDROP TABLE c;",
+			expected: []string{
+				"CREATE TRIGGER IF NOT EXISTS a_update AFTER UPDATE ON a\nBEGIN\n    DELETE FROM b WHERE id = old.id;\n    INSERT INTO b(id) VALUES (new.id);\nEND",
+				"create temp trigger a_delete after delete on a begin delete from b; end",
+				"DROP TABLE c",
+			},
+		},
+		{
+			name:     "should not cut inside quotes",
+			content:  "INSERT INTO a VALUES ('uno; dos', 'it''s; END');\nCREATE TABLE \"a;b\" (id INTEGER);",
+			expected: []string{"INSERT INTO a VALUES ('uno; dos', 'it''s; END')", "CREATE TABLE \"a;b\" (id INTEGER)"},
+		},
+		{
+			name:     "should not end a trigger at an END inside quotes",
+			content:  "CREATE TRIGGER a_insert AFTER INSERT ON a BEGIN INSERT INTO b VALUES ('END'); INSERT INTO b VALUES ('x;END'); END;\nDROP TABLE c;",
+			expected: []string{"CREATE TRIGGER a_insert AFTER INSERT ON a BEGIN INSERT INTO b VALUES ('END'); INSERT INTO b VALUES ('x;END'); END", "DROP TABLE c"},
+		},
+		{
+			name:     "should leave comments out",
+			content:  "-- cabecera; sin sentencia\nCREATE TABLE a (id INTEGER); -- fin;\n/* bloque; END; */ DROP TABLE b;\n-- último",
+			expected: []string{"CREATE TABLE a (id INTEGER)", "DROP TABLE b"},
+		},
+		{
+			name:     "should not end a trigger at an END inside a comment",
+			content:  "CREATE TRIGGER a_insert AFTER INSERT ON a BEGIN\n    DELETE FROM b; -- END;\nEND;",
+			expected: []string{"CREATE TRIGGER a_insert AFTER INSERT ON a BEGIN\n    DELETE FROM b; \nEND"},
+		},
+		{
+			name:     "should return nothing for an empty migration",
+			content:  " ;\n-- nada\n;",
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, splitStatements(tt.content))
+		})
+	}
+}
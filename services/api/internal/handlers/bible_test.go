@@ -1,15 +1,24 @@
 package handlers_test
 
 import (
+	"bytes"
+	"context"
+	"database/sql"
 	"errors"
 	"github.com/dot-backend/synergetic-craft/clienthttp"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"services/api/domain/entities"
+	"services/api/internal/actions"
 	"services/api/internal/actions/mocks"
+	"services/api/internal/bibleref"
 	"services/api/internal/handlers"
 	"services/api/testutils"
 	"testing"
+	"time"
 )
 
 func TestBibleHandler_VerifyBibleReference(t *testing.T) {
@@ -100,6 +109,603 @@ func TestBibleHandler_GetBibleReferences(t *testing.T) {
 	})
 }
 
+func TestBibleHandler_ListBibles(t *testing.T) {
+	t.Run("should return 200 with installed bibles", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectListBibles(nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bibles").Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 500 when bibles cannot be listed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectListBibles(errors.New("db closed"))
+
+		request := clienthttp.NewRequest("GET", "/v1/bibles").Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 500, rec.Code)
+	})
+}
+
+func TestBibleHandler_ImportBible(t *testing.T) {
+	t.Run("should return 200 when osis file is imported", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().ImportBible(gomock.Any(), gomock.Any(), entities.BibleImportOptions{}).
+			DoAndReturn(func(_ context.Context, bible entities.BibleImport, _ entities.BibleImportOptions) (*entities.BibleImportResult, error) {
+				assert.Equal(t, "King James Version", bible.Name)
+				assert.Equal(t, "KJV", bible.Abbreviation)
+				assert.Equal(t, "juan", bible.Books[0].Name)
+				return &entities.BibleImportResult{Bible: &entities.Bible{ID: 2, Name: bible.Name}}, nil
+			})
+
+		osis := `<osis><osisText osisIDWork="KJV" xml:lang="en"><header><work><title>King James Version</title></work></header>` +
+			`<div type="book" osisID="John"><chapter osisID="John.3"><verse osisID="John.3.16">For God so loved the world</verse></chapter></div></osisText></osis>`
+		request := multipartRequest(t, "/v1/bibles/import", "kjv.xml", osis)
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should read the license and take the attribution from the form", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().ImportBible(gomock.Any(), gomock.Any(), entities.BibleImportOptions{}).
+			DoAndReturn(func(_ context.Context, bible entities.BibleImport, _ entities.BibleImportOptions) (*entities.BibleImportResult, error) {
+				assert.Equal(t, "Copyright 2011 Crossway", bible.License)
+				assert.Equal(t, "ESV", bible.Attribution)
+				return &entities.BibleImportResult{Bible: &entities.Bible{ID: 3, Name: bible.Name}}, nil
+			})
+
+		osis := `<osis><osisText osisIDWork="ESV" xml:lang="en"><header><work><title>English Standard Version</title><rights>Copyright 2011 Crossway</rights></work></header>` +
+			`<div type="book" osisID="John"><chapter osisID="John.3"><verse osisID="John.3.16">For God so loved the world</verse></chapter></div></osisText></osis>`
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "esv.xml")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte(osis))
+		assert.NoError(t, err)
+		assert.NoError(t, writer.WriteField("attribution", "ESV"))
+		assert.NoError(t, writer.Close())
+		request := httptest.NewRequest("POST", "/v1/bibles/import", body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 422 with the report when the bible is incomplete", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		validation := entities.ImportValidation{MissingBooks: []string{"genesis"}}
+		f.action.EXPECT().ImportBible(gomock.Any(), gomock.Any(), entities.BibleImportOptions{}).
+			Return(&entities.BibleImportResult{Validation: validation}, actions.ErrIncompleteBible)
+
+		osis := `<osis><osisText osisIDWork="KJV"><div type="book" osisID="John"><chapter osisID="John.3"><verse osisID="John.3.16">For God so loved the world</verse></chapter></div></osisText></osis>`
+		request := multipartRequest(t, "/v1/bibles/import", "kjv.xml", osis)
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 422, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"missingBooks":["genesis"]`)
+	})
+
+	t.Run("should pass force to import an incomplete bible", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().ImportBible(gomock.Any(), gomock.Any(), entities.BibleImportOptions{Force: true}).
+			Return(&entities.BibleImportResult{Bible: &entities.Bible{ID: 2, Name: "KJV"}}, nil)
+
+		osis := `<osis><osisText osisIDWork="KJV"><div type="book" osisID="John"><chapter osisID="John.3"><verse osisID="John.3.16">For God so loved the world</verse></chapter></div></osisText></osis>`
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "kjv.xml")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte(osis))
+		assert.NoError(t, err)
+		assert.NoError(t, writer.WriteField("force", "true"))
+		assert.NoError(t, writer.Close())
+		request := httptest.NewRequest("POST", "/v1/bibles/import", body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 400 when no file is provided", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("POST", "/v1/bibles/import").Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+}
+
+func TestBibleHandler_UpdateBible(t *testing.T) {
+	t.Run("should return 200 with the updated bible", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().UpdateBible(gomock.Any(), entities.BibleMetadata{
+			ID:           2,
+			Name:         stringPointer("Nueva Versión Internacional"),
+			Abbreviation: stringPointer("NVI"),
+			Attribution:  stringPointer("NVI © Biblica"),
+		}).Return(&entities.Bible{ID: 2, Name: "Nueva Versión Internacional", Abbreviation: "NVI", Attribution: "NVI © Biblica"}, nil)
+
+		request := clienthttp.NewRequest("PUT", "/v1/bibles/2").
+			WithBody([]byte(`{"name": "Nueva Versión Internacional", "abbreviation": "NVI", "attribution": "NVI © Biblica"}`)).
+			WithHeader("Content-Type", "application/json").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"attribution":"NVI © Biblica"`)
+	})
+
+	t.Run("should leave out the fields the request omits", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().UpdateBible(gomock.Any(), entities.BibleMetadata{ID: 2, License: stringPointer("")}).
+			Return(&entities.Bible{ID: 2, Name: "Nueva Versión Internacional"}, nil)
+
+		request := clienthttp.NewRequest("PUT", "/v1/bibles/2").
+			WithBody([]byte(`{"license": ""}`)).
+			WithHeader("Content-Type", "application/json").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 404 when the bible is not installed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().UpdateBible(gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows)
+
+		request := clienthttp.NewRequest("PUT", "/v1/bibles/9").
+			WithBody([]byte(`{"name": "NVI"}`)).
+			WithHeader("Content-Type", "application/json").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 404, rec.Code)
+	})
+}
+
+func TestBibleHandler_ParseReference(t *testing.T) {
+	t.Run("should return 200 with parsed ranges", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectParseReference("Jn 3:16-18", nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parse").
+			WithQueryParam("ref", "Jn 3:16-18").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 400 when reference is invalid", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectParseReference("Jn 3:99", &bibleref.Error{Input: "Juan 3:99", Reason: "Juan 3 has no verse 99 (it has 36)"})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parse").
+			WithQueryParam("ref", "Jn 3:99").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+		assert.Contains(t, rec.Body.String(), "has no verse 99")
+	})
+
+	t.Run("should return 500 when chapter sizes cannot be loaded", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectParseReference("Jn 3:16", errors.New("db closed"))
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parse").
+			WithQueryParam("ref", "Jn 3:16").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 500, rec.Code)
+	})
+}
+
+func TestBibleHandler_GetPassage(t *testing.T) {
+	t.Run("should return 200 with the passage", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetPassage("Romanos 8:28-9:5", nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage").
+			WithQueryParam("ref", "Romanos 8:28-9:5").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"reference":"Romanos 8:28-9:5"`)
+	})
+
+	t.Run("should return 400 when reference is invalid", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetPassage("Romanos 17", &bibleref.Error{Input: "Romanos 17", Reason: "Romanos has no chapter 17 (it has 16)"})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage").
+			WithQueryParam("ref", "Romanos 17").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 400 when ref is missing", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage").Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+}
+
+func TestBibleHandler_GetParallelPassage(t *testing.T) {
+	t.Run("should return 200 with aligned verses", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetParallelPassage("Sal 23", []int{1, 2}, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parallel").
+			WithQueryParam("ref", "Sal 23").
+			WithQueryParam("versions", "1,2").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 400 when only one version is requested", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parallel").
+			WithQueryParam("ref", "Sal 23").
+			WithQueryParam("versions", "1").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 404 when a version is not installed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetParallelPassage("Sal 23", []int{1, 9}, sql.ErrNoRows)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/parallel").
+			WithQueryParam("ref", "Sal 23").
+			WithQueryParam("versions", "1,9").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 404, rec.Code)
+	})
+}
+
+func TestBibleHandler_ListBooks(t *testing.T) {
+	t.Run("should return 200 with the book catalog", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectListBooks(2, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/books").
+			WithQueryParam("version", "2").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"book":"rut"`)
+		assert.Contains(t, rec.Body.String(), `"verses":[22,23,18,22]`)
+	})
+
+	t.Run("should return 404 when the version is not installed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectListBooks(9, sql.ErrNoRows)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/books").
+			WithQueryParam("version", "9").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 404, rec.Code)
+	})
+}
+
+func TestBibleHandler_GetRelatedVerses(t *testing.T) {
+	t.Run("should return 200 with ranked related passages", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetRelatedVerses(entities.RequestRelated{Book: "juan", Chapter: 3, Verse: 16, Version: 2}, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/juan/3/16/related").
+			WithQueryParam("version", "2").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"reference":"Romanos 5:8"`)
+	})
+
+	t.Run("should resolve an abbreviated book name", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetRelatedVerses(entities.RequestRelated{Book: "romanos", Chapter: 8, Verse: 28}, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/Rom/8/28/related").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return 400 when book is unknown", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/xyz/3/16/related").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 404 when the version is not installed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.expectGetRelatedVerses(entities.RequestRelated{Book: "juan", Chapter: 3, Verse: 16, Version: 9}, sql.ErrNoRows)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/juan/3/16/related").
+			WithQueryParam("version", "9").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 404, rec.Code)
+	})
+}
+
+func TestBibleHandler_GetPassageSlides(t *testing.T) {
+	t.Run("should return 200 with labelled slides", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().GetPassageSlides(gomock.Any(), entities.RequestSlides{Ref: "Juan 3:16", Version: 1, MaxChars: 80, FontSize: 48}).
+			Return(&entities.PassageSlides{
+				Reference: "Juan 3:16",
+				Slides: []entities.Slide{
+					{Label: "Juan 3:16 (1/2)", Reference: "Juan 3:16", Book: "juan", Chapter: 3, Verse: 16, Part: 1, Parts: 2, Text: "Porque de tal manera amó Dios al mundo,"},
+					{Label: "Juan 3:16 (2/2)", Reference: "Juan 3:16", Book: "juan", Chapter: 3, Verse: 16, Part: 2, Parts: 2, Text: "que ha dado a su Hijo unigénito,"},
+				},
+			}, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage/slides").
+			WithQueryParam("ref", "Juan 3:16").
+			WithQueryParam("version", "1").
+			WithQueryParam("maxChars", "80").
+			WithQueryParam("fontSize", "48").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"label":"Juan 3:16 (2/2)"`)
+	})
+
+	t.Run("should return 400 when budget is negative", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage/slides").
+			WithQueryParam("ref", "Juan 3:16").
+			WithQueryParam("maxChars", "-1").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 400 when reference is invalid", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().GetPassageSlides(gomock.Any(), entities.RequestSlides{Ref: "Juan 99"}).
+			Return(nil, &bibleref.Error{Input: "Juan 99", Reason: "Juan has no chapter 99 (it has 21)"})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/passage/slides").
+			WithQueryParam("ref", "Juan 99").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+}
+
+func TestBibleHandler_GetVerseOfTheDay(t *testing.T) {
+	t.Run("should return 200 with the verse of the requested date", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().GetVerseOfTheDay(gomock.Any(), gomock.Any(), 2).
+			DoAndReturn(func(_ context.Context, date time.Time, _ int) (*entities.VerseOfTheDay, error) {
+				assert.Equal(t, "2026-10-16", date.Format("2006-01-02"))
+				return &entities.VerseOfTheDay{
+					Date:      "2026-10-16",
+					Reference: "Juan 3:16",
+					Book:      "juan",
+					Chapter:   3,
+					Verses:    []entities.Verse{{Index: 16, Text: "Porque de tal manera amó Dios al mundo"}},
+				}, nil
+			})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/verse-of-the-day").
+			WithQueryParam("date", "2026-10-16").
+			WithQueryParam("version", "2").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"reference":"Juan 3:16"`)
+	})
+
+	t.Run("should return 400 when date is invalid", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/verse-of-the-day").
+			WithQueryParam("date", "16/10/2026").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 404 when the version is not installed", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().GetVerseOfTheDay(gomock.Any(), gomock.Any(), 9).
+			Return(nil, sql.ErrNoRows)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/verse-of-the-day").
+			WithQueryParam("version", "9").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 404, rec.Code)
+	})
+}
+
+func TestBibleHandler_SearchVerses(t *testing.T) {
+	t.Run("should return 200 with parsed query", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().SearchVerses(gomock.Any(), gomock.Any(), 0, 0, 0).
+			DoAndReturn(func(_ context.Context, query entities.SearchQuery, _ int, _ int, _ int) (*entities.SearchResult, error) {
+				assert.Len(t, query.Groups, 2)
+				assert.Equal(t, []string{"no", "temas"}, query.Groups[0][0].Words)
+				assert.Len(t, query.Groups[1], 2)
+				assert.Equal(t, []string{"muerte"}, query.Excluded[0].Words)
+				assert.Equal(t, [][]string{{"salmos"}}, query.Books)
+				return &entities.SearchResult{Results: []entities.VerseMatch{}, Facets: []entities.SearchFacet{}}, nil
+			})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/search").
+			WithQueryParam("q", `"no temas" gracia OR misericordia -muerte libro:salmos`).
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+	})
+
+	t.Run("should return the page envelope with offset and facets", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		next := 20
+		f.action.EXPECT().SearchVerses(gomock.Any(), gomock.Any(), 1, 10, 10).
+			Return(&entities.SearchResult{
+				Total:      120,
+				Offset:     10,
+				Limit:      10,
+				NextOffset: &next,
+				Results:    []entities.VerseMatch{{Book: "juan", Chapter: 3, Verse: 16, Text: "Porque de tal manera amó Dios al mundo"}},
+				Facets:     []entities.SearchFacet{{Book: "juan", Name: "Juan", Count: 120}},
+			}, nil)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/search").
+			WithQueryParam("q", "amor").
+			WithQueryParam("version", "1").
+			WithQueryParam("limit", "10").
+			WithQueryParam("offset", "10").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"total":120`)
+		assert.Contains(t, rec.Body.String(), `"nextOffset":20`)
+		assert.Contains(t, rec.Body.String(), `"facets":[{"book":"juan","name":"Juan","count":120}]`)
+	})
+
+	t.Run("should pass fuzzy mode to the search", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+		f.action.EXPECT().SearchVerses(gomock.Any(), gomock.Any(), 0, 0, 0).
+			DoAndReturn(func(_ context.Context, query entities.SearchQuery, _ int, _ int, _ int) (*entities.SearchResult, error) {
+				assert.True(t, query.Fuzzy)
+				return &entities.SearchResult{
+					Results:     []entities.VerseMatch{{Book: "salmos", Chapter: 1, Verse: 1, Text: "Bienaventurado el varón"}},
+					Facets:      []entities.SearchFacet{{Book: "salmos", Name: "Salmos", Count: 1}},
+					Total:       1,
+					Approximate: true,
+					Suggestions: []entities.SearchSuggestion{{Term: "bienaventurdo", Suggestions: []string{"bienaventurado"}}},
+				}, nil
+			})
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/search").
+			WithQueryParam("q", "bienaventurdo").
+			WithQueryParam("fuzzy", "true").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"approximate":true`)
+		assert.Contains(t, rec.Body.String(), `"suggestions":[{"term":"bienaventurdo","suggestions":["bienaventurado"]}]`)
+	})
+
+	t.Run("should return 400 when query only excludes words", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/search").
+			WithQueryParam("q", "-muerte").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+
+	t.Run("should return 400 when book filter is unknown", func(t *testing.T) {
+		f := setupBibleHandlerFixture(t)
+
+		request := clienthttp.NewRequest("GET", "/v1/bible/search").
+			WithQueryParam("q", "amor libro:xyz").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(f.handler, request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+	})
+}
+
+func multipartRequest(t *testing.T, target string, filename string, content string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	request := httptest.NewRequest("POST", target, body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return request
+}
+
 type bibleHandlerFixture struct {
 	handler *handlers.BibleHandler
 	action  *mocks.MockBibleActionInterface
@@ -122,5 +728,71 @@ func (a *bibleHandlerFixture) expectVerifyBibleReference(request entities.Reques
 
 func (a *bibleHandlerFixture) expectGetBibleReferences(request entities.RequestBible, err error) {
 	a.action.EXPECT().GetBibleReferences(gomock.Any(), request).
-		Return(entities.Chapter{}, err)
+		Return(&entities.Chapter{}, err)
+}
+
+func (a *bibleHandlerFixture) expectListBibles(err error) {
+	a.action.EXPECT().ListBibles(gomock.Any()).
+		Return([]entities.Bible{{ID: 1, Name: "Reina Valera 1960"}}, err)
+}
+
+func (a *bibleHandlerFixture) expectParseReference(ref string, err error) {
+	a.action.EXPECT().ParseReference(gomock.Any(), ref, 0).
+		Return([]entities.ReferenceRange{{Book: "juan", StartChapter: 3, StartVerse: 16, EndChapter: 3, EndVerse: 18}}, err)
+}
+
+func (a *bibleHandlerFixture) expectGetPassage(ref string, err error) {
+	passage := &entities.Passage{Reference: "Romanos 8:28-9:5"}
+	if err != nil {
+		passage = nil
+	}
+	a.action.EXPECT().GetPassage(gomock.Any(), ref, 0).
+		Return(passage, err)
+}
+
+func (a *bibleHandlerFixture) expectListBooks(version int, err error) {
+	books := []entities.BookInfo{{
+		Book:         "rut",
+		Name:         "Rut",
+		Position:     8,
+		Abbreviation: "Rt",
+		Testament:    "at",
+		Genre:        "historicos",
+		Chapters:     4,
+		Verses:       []int{22, 23, 18, 22},
+	}}
+	if err != nil {
+		books = nil
+	}
+	a.action.EXPECT().ListBooks(gomock.Any(), version).
+		Return(books, err)
+}
+
+func (a *bibleHandlerFixture) expectGetRelatedVerses(request entities.RequestRelated, err error) {
+	related := &entities.RelatedVerses{
+		Reference: "Juan 3:16",
+		Related: []entities.RelatedPassage{{
+			Reference: "Romanos 5:8",
+			Votes:     200,
+			Verses:    []entities.Verse{{Index: 8, Text: "Mas Dios muestra su amor para con nosotros"}},
+		}},
+	}
+	if err != nil {
+		related = nil
+	}
+	a.action.EXPECT().GetRelatedVerses(gomock.Any(), request).
+		Return(related, err)
+}
+
+func (a *bibleHandlerFixture) expectGetParallelPassage(ref string, versions []int, err error) {
+	passage := &entities.ParallelPassage{Reference: "Salmos 23"}
+	if err != nil {
+		passage = nil
+	}
+	a.action.EXPECT().GetParallelPassage(gomock.Any(), ref, versions).
+		Return(passage, err)
+}
+
+func stringPointer(value string) *string {
+	return &value
 }
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -10,10 +12,13 @@ import (
 	"path/filepath"
 	"services/api/domain/entities"
 	"services/api/internal/actions"
+	"services/api/internal/bibleimport"
+	"services/api/internal/bibleref"
+	"services/api/internal/searchquery"
 	"services/api/lib"
+	"strconv"
 	"strings"
 	"time"
-	"unicode"
 )
 
 type BibleHandler struct {
@@ -33,13 +38,126 @@ func NewBibleHandler(action actions.BibleActionInterface, videoUploadPath string
 }
 
 func (b *BibleHandler) RegisterRoutes(router *echo.Group, mws map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/bibles", b.ListBibles)
+	router.POST("/v1/bibles/import", b.ImportBible)
+	router.PUT("/v1/bibles/:id", b.UpdateBible)
+	router.GET("/v1/bible/books", b.ListBooks)
 	router.GET("/v1/bible/search", b.SearchVerses)
+	router.GET("/v1/bible/parse", b.ParseReference)
+	router.GET("/v1/bible/passage", b.GetPassage)
+	router.GET("/v1/bible/passage/slides", b.GetPassageSlides)
+	router.GET("/v1/bible/parallel", b.GetParallelPassage)
+	router.GET("/v1/bible/verse-of-the-day", b.GetVerseOfTheDay)
 	router.GET("/v1/bible/:book/:chapter/verify", b.VerifyBibleReference)
+	router.GET("/v1/bible/:book/:chapter/:verse/related", b.GetRelatedVerses)
 	router.GET("/v1/bible/:book/:chapter", b.GetBibleReferences)
 	router.POST("/upload-video", b.UploadVideo)
 	router.POST("/upload-image", b.UploadImage)
 }
 
+func (b *BibleHandler) ListBibles(c echo.Context) error {
+	ctx := c.Request().Context()
+	bibles, err := b.action.ListBibles(ctx)
+	if err != nil {
+		log.Warnf("ListBibles failed err=%v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, bibles)
+}
+
+// UpdateBible sets the catalog details of an installed version, such as the
+// license and the attribution projected with its verses.
+func (b *BibleHandler) UpdateBible(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.BibleMetadata{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind UpdateBible failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	bible, err := b.action.UpdateBible(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, actions.ErrBibleNameRequired):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		case errors.Is(err, sql.ErrNoRows):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("UpdateBible failed id=%d err=%v", req.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "update failed"})
+	}
+	return c.JSON(http.StatusOK, bible)
+}
+
+func (b *BibleHandler) ListBooks(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestBooks{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ListBooks failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	books, err := b.action.ListBooks(ctx, req.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("ListBooks failed version=%d err=%v", req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, books)
+}
+
+func (b *BibleHandler) ImportBible(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "No bible file provided"})
+	}
+
+	format := c.FormValue("format")
+	if format == "" {
+		format = bibleimport.DetectFormat(file.Filename)
+	}
+	meta := bibleimport.Metadata{
+		Name:          c.FormValue("name"),
+		Abbreviation:  c.FormValue("abbreviation"),
+		Language:      c.FormValue("language"),
+		License:       c.FormValue("license"),
+		Attribution:   c.FormValue("attribution"),
+		Versification: c.FormValue("versification"),
+	}
+	options := entities.BibleImportOptions{}
+	options.ValidateOnly, _ = strconv.ParseBool(c.FormValue("validateOnly"))
+	options.Force, _ = strconv.ParseBool(c.FormValue("force"))
+
+	src, err := file.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to open uploaded file"})
+	}
+	defer src.Close()
+
+	bible, err := bibleimport.Parse(format, src, meta)
+	if err != nil {
+		log.Warnf("ImportBible parse failed file=%s format=%s err=%v", file.Filename, format, err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := b.action.ImportBible(ctx, *bible, options)
+	if errors.Is(err, actions.ErrIncompleteBible) {
+		return c.JSON(http.StatusUnprocessableEntity, response)
+	}
+	if err != nil {
+		log.Warnf("ImportBible failed file=%s format=%s err=%v", file.Filename, format, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "import failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
 func (b *BibleHandler) GetBibleReferences(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -78,6 +196,165 @@ func (b *BibleHandler) VerifyBibleReference(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+func (b *BibleHandler) ParseReference(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestReference{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ParseReference failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	response, err := b.action.ParseReference(ctx, req.Ref, req.Version)
+	if err != nil {
+		var refErr *bibleref.Error
+		if errors.As(err, &refErr) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": refErr.Error()})
+		}
+		log.Warnf("ParseReference failed ref=%q version=%d err=%v", req.Ref, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "parse failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (b *BibleHandler) GetPassage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestPassage{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetPassage failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	response, err := b.action.GetPassage(ctx, req.Ref, req.Version)
+	if err != nil {
+		var refErr *bibleref.Error
+		if errors.As(err, &refErr) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": refErr.Error()})
+		}
+		log.Warnf("GetPassage failed ref=%q version=%d err=%v", req.Ref, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "passage failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (b *BibleHandler) GetPassageSlides(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestSlides{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetPassageSlides failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.MaxChars < 0 || req.MaxLines < 0 || req.CharsPerLine < 0 || req.FontSize < 0 || req.MaxWidth < 0 || req.Height < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "slide budget must not be negative"})
+	}
+
+	response, err := b.action.GetPassageSlides(ctx, req)
+	if err != nil {
+		var refErr *bibleref.Error
+		if errors.As(err, &refErr) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": refErr.Error()})
+		}
+		log.Warnf("GetPassageSlides failed ref=%q version=%d err=%v", req.Ref, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "slides failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (b *BibleHandler) GetParallelPassage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestParallel{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetParallelPassage failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	var versions []int
+	for _, field := range strings.Split(req.Versions, ",") {
+		version, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || version <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "versions must be a list of bible ids"})
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) < 2 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "at least two versions are required"})
+	}
+
+	response, err := b.action.GetParallelPassage(ctx, req.Ref, versions)
+	if err != nil {
+		var refErr *bibleref.Error
+		switch {
+		case errors.As(err, &refErr):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": refErr.Error()})
+		case errors.Is(err, sql.ErrNoRows):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("GetParallelPassage failed ref=%q versions=%v err=%v", req.Ref, versions, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "parallel failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (b *BibleHandler) GetRelatedVerses(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestRelated{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetRelatedVerses failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	book, err := bibleref.LookupBook(req.Book)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	req.Book = book
+
+	response, err := b.action.GetRelatedVerses(ctx, req)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("GetRelatedVerses failed book=%s chapter=%d verse=%d version=%d err=%v", req.Book, req.Chapter, req.Verse, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "related failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetVerseOfTheDay returns the daily verse for ?date=YYYY-MM-DD, today by
+// default.
+func (b *BibleHandler) GetVerseOfTheDay(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestVerseOfTheDay{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetVerseOfTheDay failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	date, err := parseLocalDate(req.Date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "date must be YYYY-MM-DD"})
+	}
+
+	response, err := b.action.GetVerseOfTheDay(ctx, date, req.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("GetVerseOfTheDay failed date=%s version=%d err=%v", req.Date, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "verse of the day failed"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
 func (b *BibleHandler) SearchVerses(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -87,17 +364,15 @@ func (b *BibleHandler) SearchVerses(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err)
 	}
 
-	terms := tokenizeSearchQuery(strings.TrimSpace(req.Query))
-	if len(terms) == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "query is required"})
-	}
-	if len(terms) > 5 {
-		terms = terms[:5]
+	query, err := searchquery.Parse(req.Query)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
+	query.Fuzzy = req.Fuzzy
 
-	response, err := b.action.SearchVerses(ctx, terms, req.Version, req.Limit)
+	response, err := b.action.SearchVerses(ctx, query, req.Version, req.Limit, req.Offset)
 	if err != nil {
-		log.Warnf("SearchVerses failed q=%q version=%d limit=%d err=%v", req.Query, req.Version, req.Limit, err)
+		log.Warnf("SearchVerses failed q=%q version=%d limit=%d offset=%d err=%v", req.Query, req.Version, req.Limit, req.Offset, err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "search failed"})
 	}
 
@@ -180,20 +455,3 @@ func (b *BibleHandler) UploadImage(c echo.Context) error {
 	imageURL := fmt.Sprintf("%s/images/%s", b.apiBasePath, filename)
 	return c.JSON(http.StatusOK, map[string]string{"url": imageURL})
 }
-
-func tokenizeSearchQuery(query string) []string {
-	fields := strings.FieldsFunc(query, func(r rune) bool {
-		return !(unicode.IsLetter(r) || unicode.IsNumber(r))
-	})
-
-	terms := make([]string, 0, len(fields))
-	for _, field := range fields {
-		trimmed := strings.TrimSpace(field)
-		if len(trimmed) < 2 {
-			continue
-		}
-		terms = append(terms, trimmed)
-	}
-
-	return terms
-}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/lib"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type BookAliasHandler struct {
+	action actions.BookAliasActionInterface
+}
+
+func NewBookAliasHandler(action actions.BookAliasActionInterface) *BookAliasHandler {
+	return &BookAliasHandler{action: action}
+}
+
+func (h *BookAliasHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/bible/aliases", h.ListBookAliases)
+	router.POST("/v1/bible/aliases", h.AddBookAlias)
+	router.DELETE("/v1/bible/aliases/:id", h.DeleteBookAlias)
+}
+
+func (h *BookAliasHandler) ListBookAliases(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestBookAliases{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ListBookAliases failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	aliases, err := h.action.ListBookAliases(ctx, req.Book)
+	if err != nil {
+		if errors.Is(err, actions.ErrUnknownBook) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "unknown book"})
+		}
+		log.Warnf("ListBookAliases failed book=%s err=%v", req.Book, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, aliases)
+}
+
+// AddBookAlias stores a custom alias, which the references and the search
+// filters accept right away.
+func (h *BookAliasHandler) AddBookAlias(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	payload := entities.BookAliasPayload{}
+	if err := lib.Bind(c, &payload); err != nil {
+		log.Warnf("bind AddBookAlias failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	alias, err := h.action.AddBookAlias(ctx, payload)
+	if err != nil {
+		var conflict *actions.BookAliasConflictError
+		switch {
+		case errors.Is(err, actions.ErrUnknownBook):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "unknown book"})
+		case errors.As(err, &conflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": conflict.Error()})
+		}
+		log.Warnf("AddBookAlias failed book=%s alias=%s err=%v", payload.Book, payload.Alias, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "save failed"})
+	}
+	return c.JSON(http.StatusOK, alias)
+}
+
+// DeleteBookAlias removes a custom alias. The seeded ones cannot be
+// removed and answer 404.
+func (h *BookAliasHandler) DeleteBookAlias(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	if err := h.action.DeleteBookAlias(ctx, id); err != nil {
+		if errors.Is(err, actions.ErrBookAliasNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("DeleteBookAlias failed id=%d err=%v", id, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "delete failed"})
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/bibleref"
+	"services/api/lib"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type CollectionHandler struct {
+	action actions.CollectionActionInterface
+}
+
+func NewCollectionHandler(action actions.CollectionActionInterface) *CollectionHandler {
+	return &CollectionHandler{action: action}
+}
+
+func (h *CollectionHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/collections", h.ListCollections)
+	router.GET("/v1/collections/:id", h.GetCollection)
+	router.GET("/v1/collections/:id/text", h.GetCollectionText)
+	router.POST("/v1/collections", h.UpsertCollection)
+	router.DELETE("/v1/collections/:id", h.DeleteCollection)
+}
+
+func (h *CollectionHandler) ListCollections(c echo.Context) error {
+	ctx := c.Request().Context()
+	collections, err := h.action.ListCollections(ctx)
+	if err != nil {
+		log.Warnf("ListCollections failed err=%v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, collections)
+}
+
+func (h *CollectionHandler) GetCollection(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "id is required"})
+	}
+	collection, err := h.action.GetCollection(ctx, id)
+	if err != nil {
+		if errors.Is(err, actions.ErrCollectionNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("GetCollection failed id=%s err=%v", id, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "get failed"})
+	}
+	return c.JSON(http.StatusOK, collection)
+}
+
+// GetCollectionText returns the collection with the text of every item in
+// the requested version.
+func (h *CollectionHandler) GetCollectionText(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestCollectionText{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetCollectionText failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	text, err := h.action.GetCollectionText(ctx, req.ID, req.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, actions.ErrCollectionNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, sql.ErrNoRows):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("GetCollectionText failed id=%s version=%d err=%v", req.ID, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "text failed"})
+	}
+	return c.JSON(http.StatusOK, text)
+}
+
+func (h *CollectionHandler) UpsertCollection(c echo.Context) error {
+	ctx := c.Request().Context()
+	var payload entities.VerseCollectionPayload
+	if err := json.NewDecoder(c.Request().Body).Decode(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid payload"})
+	}
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name is required"})
+	}
+	if payload.ID == "" {
+		payload.ID = fmt.Sprintf("col-%d", time.Now().UnixNano())
+	}
+	collection, err := h.action.UpsertCollection(ctx, payload)
+	if err != nil {
+		var refErr *bibleref.Error
+		if errors.As(err, &refErr) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": refErr.Error()})
+		}
+		log.Warnf("UpsertCollection failed id=%s err=%v", payload.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "save failed"})
+	}
+	return c.JSON(http.StatusOK, collection)
+}
+
+func (h *CollectionHandler) DeleteCollection(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "id is required"})
+	}
+	if err := h.action.DeleteCollection(ctx, id); err != nil {
+		log.Warnf("DeleteCollection failed id=%s err=%v", id, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "delete failed"})
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
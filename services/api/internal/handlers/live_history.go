@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/lib"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type LiveHistoryHandler struct {
+	action actions.LiveHistoryActionInterface
+}
+
+func NewLiveHistoryHandler(action actions.LiveHistoryActionInterface) *LiveHistoryHandler {
+	return &LiveHistoryHandler{action: action}
+}
+
+func (h *LiveHistoryHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/live/history", h.ListLiveHistory)
+	router.GET("/v1/live/history/days", h.ListLiveHistoryDays)
+	router.GET("/v1/live/history/export", h.ExportLiveHistory)
+}
+
+func (h *LiveHistoryHandler) ListLiveHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLiveHistory{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ListLiveHistory failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	day, err := parseLocalDate(req.Date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "date must be YYYY-MM-DD"})
+	}
+
+	entries, err := h.action.ListLiveHistory(ctx, day)
+	if err != nil {
+		log.Warnf("ListLiveHistory failed date=%s err=%v", req.Date, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, entries)
+}
+
+func (h *LiveHistoryHandler) ListLiveHistoryDays(c echo.Context) error {
+	ctx := c.Request().Context()
+	days, err := h.action.ListLiveHistoryDays(ctx)
+	if err != nil {
+		log.Warnf("ListLiveHistoryDays failed err=%v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, days)
+}
+
+// ExportLiveHistory downloads the history of one day as a service report,
+// CSV by default or JSON with format=json.
+func (h *LiveHistoryHandler) ExportLiveHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLiveHistory{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ExportLiveHistory failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	day, err := parseLocalDate(req.Date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "date must be YYYY-MM-DD"})
+	}
+	if req.Format == "" {
+		req.Format = "csv"
+	}
+	if req.Format != "csv" && req.Format != "json" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be csv or json"})
+	}
+
+	entries, err := h.action.ListLiveHistory(ctx, day)
+	if err != nil {
+		log.Warnf("ExportLiveHistory failed date=%s err=%v", req.Date, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "export failed"})
+	}
+
+	filename := fmt.Sprintf("servicio-%s.%s", day.Format("2006-01-02"), req.Format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	if req.Format == "json" {
+		return c.JSON(http.StatusOK, entries)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().WriteHeader(http.StatusOK)
+	writer := csv.NewWriter(c.Response())
+	_ = writer.Write([]string{"started_at", "ended_at", "duration_seconds", "type", "reference", "song_id", "title", "slides", "source"})
+	for _, entry := range entries {
+		_ = writer.Write([]string{
+			entry.StartedAt,
+			entry.EndedAt,
+			strconv.Itoa(entry.Duration),
+			entry.Type,
+			entry.Reference,
+			entry.SongID,
+			entry.Title,
+			strconv.Itoa(entry.Slides),
+			entry.Source,
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// parseLocalDate reads a local calendar day, today when empty.
+func parseLocalDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now(), nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
@@ -1,11 +1,17 @@
 package handlers
 
 import (
+	"bytes"
+	"database/sql"
+	"errors"
 	"fmt"
+	"io"
 	"services/api/domain/entities"
 	"services/api/internal/actions"
+	"services/api/internal/songs"
 	"services/api/lib"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -22,19 +28,48 @@ func NewLyricsHandler(action actions.LyricsActionInterface) *LyricsHandler {
 
 func (h *LyricsHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
 	router.GET("/v1/lyrics", h.ListSongs)
+	router.GET("/v1/lyrics/export", h.ExportSongs)
+	router.GET("/v1/lyrics/tags", h.ListTags)
 	router.GET("/v1/lyrics/:id", h.GetSong)
+	router.GET("/v1/lyrics/:id/export", h.ExportSong)
+	router.GET("/v1/lyrics/:id/chords", h.RenderChords)
+	router.GET("/v1/lyrics/:id/slides", h.GetSlides)
 	router.POST("/v1/lyrics", h.UpsertSong)
+	router.POST("/v1/lyrics/import", h.ImportSongs)
+	router.POST("/v1/lyrics/segment", h.SegmentLyrics)
 	router.DELETE("/v1/lyrics/:id", h.DeleteSong)
 }
 
+// ListSongs lists the library, filtered by ?q=, ?key=, ?tag= and
+// ?tempoMin=/?tempoMax= and sorted by ?sort=title|key|tempo|updated.
 func (h *LyricsHandler) ListSongs(c echo.Context) error {
 	ctx := c.Request().Context()
-	songs, err := h.action.ListSongs(ctx)
+
+	req := entities.RequestLyricsSongs{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ListSongs failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	list, err := h.action.ListSongs(ctx, req)
 	if err != nil {
+		if errors.Is(err, songs.ErrInvalidKey) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid key"})
+		}
 		log.Warnf("ListSongs failed err=%v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
 	}
-	return c.JSON(http.StatusOK, songs)
+	return c.JSON(http.StatusOK, list)
+}
+
+func (h *LyricsHandler) ListTags(c echo.Context) error {
+	ctx := c.Request().Context()
+	tags, err := h.action.ListTags(ctx)
+	if err != nil {
+		log.Warnf("ListTags failed err=%v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, tags)
 }
 
 func (h *LyricsHandler) GetSong(c echo.Context) error {
@@ -50,6 +85,31 @@ func (h *LyricsHandler) GetSong(c echo.Context) error {
 	return c.JSON(http.StatusOK, song)
 }
 
+// GetSlides returns the slides of a song in the order of
+// ?arrangement=<id>, or of its default arrangement.
+func (h *LyricsHandler) GetSlides(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLyricsSlides{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetSlides failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	slides, err := h.action.GetSlides(ctx, req.ID, req.Arrangement)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		if errors.Is(err, songs.ErrUnknownArrangement) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "arrangement not found"})
+		}
+		log.Warnf("GetSlides failed id=%s arrangement=%s err=%v", req.ID, req.Arrangement, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "slides failed"})
+	}
+	return c.JSON(http.StatusOK, slides)
+}
+
 func (h *LyricsHandler) UpsertSong(c echo.Context) error {
 	ctx := c.Request().Context()
 	var payload entities.LyricsSongPayload
@@ -64,12 +124,32 @@ func (h *LyricsHandler) UpsertSong(c echo.Context) error {
 	}
 	song, err := h.action.UpsertSong(ctx, payload)
 	if err != nil {
+		if errors.Is(err, songs.ErrInvalidKey) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid key"})
+		}
 		log.Warnf("UpsertSong failed id=%s err=%v", payload.ID, err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "save failed"})
 	}
 	return c.JSON(http.StatusOK, song)
 }
 
+// SegmentLyrics proposes the segments of a pasted text, with its stanzas
+// split into slides of at most maxLines lines, 4 when it is not given.
+func (h *LyricsHandler) SegmentLyrics(c echo.Context) error {
+	req := entities.RequestLyricsSegment{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind SegmentLyrics failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.MaxLines < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "maxLines must not be negative"})
+	}
+	if req.MaxLines == 0 {
+		req.MaxLines = songs.DefaultSlideLines
+	}
+	return c.JSON(http.StatusOK, songs.SplitLyrics(req.Text, req.MaxLines))
+}
+
 func (h *LyricsHandler) DeleteSong(c echo.Context) error {
 	ctx := c.Request().Context()
 	id := c.Param("id")
@@ -82,3 +162,204 @@ func (h *LyricsHandler) DeleteSong(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
+
+// Song file formats. Songs are imported and exported as OpenLyrics or
+// ChordPro; chord charts are also rendered as plain text.
+const (
+	formatOpenLyrics = "openlyrics"
+	formatChordPro   = "chordpro"
+	formatText       = "text"
+)
+
+// ImportSongs reads an OpenLyrics or ChordPro file, or a zip of them, into
+// the library and reports what happened to every song. The format defaults
+// to ChordPro for .cho/.chordpro/.pro files and to OpenLyrics otherwise.
+// With existing=skip the songs already in the library are not merged.
+func (h *LyricsHandler) ImportSongs(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "No song file provided"})
+	}
+	format := c.FormValue("format")
+	if format == "" {
+		format = formatOpenLyrics
+		if songs.IsChordProFile(file.Filename) {
+			format = formatChordPro
+		}
+	}
+	if format != formatOpenLyrics && format != formatChordPro {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be openlyrics or chordpro"})
+	}
+	existing := c.FormValue("existing")
+	if existing != "" && existing != "merge" && existing != "skip" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "existing must be merge or skip"})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to open uploaded file"})
+	}
+	defer src.Close()
+	content, err := io.ReadAll(src)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to read uploaded file"})
+	}
+
+	read := songs.ReadOpenLyrics
+	if format == formatChordPro {
+		read = songs.ReadChordPro
+	}
+	files, err := read(file.Filename, content)
+	if err != nil {
+		log.Warnf("ImportSongs parse failed file=%s err=%v", file.Filename, err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	report, err := h.action.ImportSongs(ctx, files, existing == "skip")
+	if err != nil {
+		log.Warnf("ImportSongs failed file=%s err=%v", file.Filename, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "import failed"})
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// ExportSong downloads one song as an OpenLyrics file, or as ChordPro with
+// format=chordpro.
+func (h *LyricsHandler) ExportSong(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLyricsExport{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ExportSong failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.Format == "" {
+		req.Format = formatOpenLyrics
+	}
+	if req.Format != formatOpenLyrics && req.Format != formatChordPro {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be openlyrics or chordpro"})
+	}
+
+	song, err := h.action.GetSong(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("ExportSong failed id=%s err=%v", req.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "export failed"})
+	}
+
+	var body bytes.Buffer
+	ext, contentType := ".xml", "application/xml; charset=utf-8"
+	if req.Format == formatChordPro {
+		ext, contentType = ".cho", "text/plain; charset=utf-8"
+		err = songs.WriteChordPro(&body, *song, songs.ChordOptions{})
+	} else {
+		err = songs.WriteOpenLyrics(&body, *song)
+	}
+	if err != nil {
+		log.Warnf("ExportSong failed id=%s err=%v", req.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "export failed"})
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", songs.FileName(song.Title, ext)))
+	return c.Blob(http.StatusOK, contentType, body.Bytes())
+}
+
+// ExportSongs downloads a zip of OpenLyrics, or ChordPro, files with the
+// songs in ?ids=a,b, or the whole library.
+func (h *LyricsHandler) ExportSongs(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLyricsExport{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind ExportSongs failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.Format == "" {
+		req.Format = formatOpenLyrics
+	}
+	if req.Format != formatOpenLyrics && req.Format != formatChordPro {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be openlyrics or chordpro"})
+	}
+	var ids []string
+	for _, id := range strings.Split(req.IDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	exported, err := h.action.ExportSongs(ctx, ids)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("ExportSongs failed ids=%s err=%v", req.IDs, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "export failed"})
+	}
+
+	write := songs.WriteOpenLyricsZip
+	if req.Format == formatChordPro {
+		write = songs.WriteChordProZip
+	}
+	var body bytes.Buffer
+	if err := write(&body, exported); err != nil {
+		log.Warnf("ExportSongs failed ids=%s err=%v", req.IDs, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "export failed"})
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="canciones.zip"`)
+	return c.Blob(http.StatusOK, "application/zip", body.Bytes())
+}
+
+// RenderChords writes the chord chart of a song as ChordPro, or as chords
+// over the lyrics with format=text. It is transposed to ?key=A, which
+// must be in the mode of the song, or by ?transpose=-2 semitones, and
+// ?capo=3 writes the chords as played with a capo on that fret. The
+// sections follow ?arrangement=<id>, or the default arrangement.
+func (h *LyricsHandler) RenderChords(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestLyricsChords{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind RenderChords failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.Format == "" {
+		req.Format = formatChordPro
+	}
+	if req.Format != formatChordPro && req.Format != formatText {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be chordpro or text"})
+	}
+
+	song, err := h.action.GetSong(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("RenderChords failed id=%s err=%v", req.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "render failed"})
+	}
+
+	options := songs.ChordOptions{Key: req.Key, Transpose: req.Transpose, Capo: req.Capo, Arrangement: req.Arrangement}
+	var body bytes.Buffer
+	if req.Format == formatText {
+		err = songs.WriteChordSheet(&body, *song, options)
+	} else {
+		err = songs.WriteChordPro(&body, *song, options)
+	}
+	if err != nil {
+		if errors.Is(err, songs.ErrInvalidKey) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid key"})
+		}
+		if errors.Is(err, songs.ErrKeyMode) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": songs.ErrKeyMode.Error()})
+		}
+		if errors.Is(err, songs.ErrUnknownArrangement) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "arrangement not found"})
+		}
+		log.Warnf("RenderChords failed id=%s err=%v", req.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "render failed"})
+	}
+	return c.Blob(http.StatusOK, "text/plain; charset=utf-8", body.Bytes())
+}
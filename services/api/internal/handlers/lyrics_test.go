@@ -0,0 +1,40 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"github.com/dot-backend/synergetic-craft/clienthttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/handlers"
+	"services/api/testutils"
+	"testing"
+)
+
+func TestLyricsHandler_SegmentLyrics(t *testing.T) {
+	t.Run("should split stanzas into slides of 4 lines when maxLines is not given", func(t *testing.T) {
+		request := clienthttp.NewRequest("POST", "/v1/lyrics/segment").
+			WithBody([]byte(`{"text": "uno\ndos\ntres\ncuatro\ncinco"}`)).
+			WithHeader("Content-Type", "application/json").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(handlers.NewLyricsHandler(nil), request, nil)
+
+		require.Equal(t, 200, rec.Code)
+		split := entities.LyricsSegmentation{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &split))
+		assert.Equal(t, []string{"v1a", "v1b"}, split.Sequence)
+	})
+
+	t.Run("should return 400 when maxLines is negative", func(t *testing.T) {
+		request := clienthttp.NewRequest("POST", "/v1/lyrics/segment").
+			WithBody([]byte(`{"text": "uno\ndos", "maxLines": -1}`)).
+			WithHeader("Content-Type", "application/json").
+			Build()
+
+		rec := testutils.ServerWithMiddlewares(handlers.NewLyricsHandler(nil), request, nil)
+
+		assert.Equal(t, 400, rec.Code)
+		assert.JSONEq(t, `{"error": "maxLines must not be negative"}`, rec.Body.String())
+	})
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/internal/readingplan"
+	"services/api/lib"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type PlanHandler struct {
+	action actions.PlanActionInterface
+}
+
+func NewPlanHandler(action actions.PlanActionInterface) *PlanHandler {
+	return &PlanHandler{action: action}
+}
+
+func (h *PlanHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/plans", h.ListPlans)
+	router.POST("/v1/plans/import", h.ImportPlan)
+	router.GET("/v1/plans/:id", h.GetPlan)
+	router.GET("/v1/plans/:id/today", h.GetPlanToday)
+	router.DELETE("/v1/plans/:id", h.DeletePlan)
+}
+
+func (h *PlanHandler) ListPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+	plans, err := h.action.ListPlans(ctx)
+	if err != nil {
+		log.Warnf("ListPlans failed err=%v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "list failed"})
+	}
+	return c.JSON(http.StatusOK, plans)
+}
+
+func (h *PlanHandler) GetPlan(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "id is required"})
+	}
+	plan, err := h.action.GetPlan(ctx, id)
+	if err != nil {
+		if errors.Is(err, actions.ErrPlanNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		log.Warnf("GetPlan failed id=%s err=%v", id, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "get failed"})
+	}
+	return c.JSON(http.StatusOK, plan)
+}
+
+// ImportPlan reads a JSON or CSV plan from the "file" form field. The name,
+// description and startDate fields override the file; passing the id of an
+// existing plan replaces its schedule.
+func (h *PlanHandler) ImportPlan(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "No plan file provided"})
+	}
+	format := c.FormValue("format")
+	if format == "" {
+		format = readingplan.DetectFormat(file.Filename)
+	}
+	meta := readingplan.Metadata{
+		Name:        c.FormValue("name"),
+		Description: c.FormValue("description"),
+		StartDate:   c.FormValue("startDate"),
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to open uploaded file"})
+	}
+	defer src.Close()
+
+	plan, err := readingplan.Parse(format, src, meta)
+	if err != nil {
+		log.Warnf("ImportPlan parse failed file=%s format=%s err=%v", file.Filename, format, err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	plan.ID = c.FormValue("id")
+	if plan.ID == "" {
+		plan.ID = fmt.Sprintf("plan-%d", time.Now().UnixNano())
+	}
+
+	response, err := h.action.ImportPlan(ctx, *plan)
+	if err != nil {
+		log.Warnf("ImportPlan failed file=%s format=%s err=%v", file.Filename, format, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "import failed"})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPlanToday returns the readings of the plan for ?date=YYYY-MM-DD,
+// today by default, with their text in ?version=.
+func (h *PlanHandler) GetPlanToday(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestPlanToday{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetPlanToday failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	date, err := parseLocalDate(req.Date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "date must be YYYY-MM-DD"})
+	}
+
+	today, err := h.action.GetPlanToday(ctx, req.ID, date, req.Version)
+	if err != nil {
+		var notStarted *actions.PlanNotStartedError
+		switch {
+		case errors.Is(err, actions.ErrPlanNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, sql.ErrNoRows):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		case errors.As(err, &notStarted):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": notStarted.Error()})
+		}
+		log.Warnf("GetPlanToday failed id=%s date=%s version=%d err=%v", req.ID, req.Date, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "today failed"})
+	}
+	return c.JSON(http.StatusOK, today)
+}
+
+func (h *PlanHandler) DeletePlan(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "id is required"})
+	}
+	if err := h.action.DeletePlan(ctx, id); err != nil {
+		log.Warnf("DeletePlan failed id=%s err=%v", id, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "delete failed"})
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
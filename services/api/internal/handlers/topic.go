@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"services/api/domain/entities"
+	"services/api/internal/actions"
+	"services/api/lib"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type TopicHandler struct {
+	action actions.TopicActionInterface
+}
+
+func NewTopicHandler(action actions.TopicActionInterface) *TopicHandler {
+	return &TopicHandler{action: action}
+}
+
+func (h *TopicHandler) RegisterRoutes(router *echo.Group, _ map[string]echo.MiddlewareFunc) {
+	router.GET("/v1/topics/search", h.SearchTopics)
+	router.GET("/v1/topics/:id/verses", h.GetTopicVerses)
+}
+
+func (h *TopicHandler) SearchTopics(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestTopicSearch{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind SearchTopics failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	topics, err := h.action.SearchTopics(ctx, req.Query, req.Limit)
+	if err != nil {
+		log.Warnf("SearchTopics failed q=%q err=%v", req.Query, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "search failed"})
+	}
+	return c.JSON(http.StatusOK, topics)
+}
+
+func (h *TopicHandler) GetTopicVerses(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := entities.RequestTopicVerses{}
+	if err := lib.Bind(c, &req); err != nil {
+		log.Warnf("bind GetTopicVerses failed: %v", err)
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit and offset must not be negative"})
+	}
+
+	verses, err := h.action.GetTopicVerses(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, actions.ErrTopicNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, sql.ErrNoRows):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "bible not found"})
+		}
+		log.Warnf("GetTopicVerses failed id=%d version=%d err=%v", req.ID, req.Version, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "verses failed"})
+	}
+	return c.JSON(http.StatusOK, verses)
+}
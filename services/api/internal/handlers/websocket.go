@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -8,7 +9,9 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 
+	"services/api/internal/actions"
 	"services/api/internal/manager"
+	"services/api/internal/songs"
 )
 
 var upgrader = websocket.Upgrader{
@@ -17,8 +20,29 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
+// historyQueueSize is how many live messages can wait for the history.
+// Messages that find the queue full are left out of the history.
+const historyQueueSize = 256
+
+type WebSocketHandler struct {
+	history chan []byte
+}
+
+// NewWebSocketHandler relays live messages between clients and passes each
+// one to the live history. The history is written by its own goroutine, so a
+// slow database never holds up the relay.
+func NewWebSocketHandler(history actions.LiveHistoryActionInterface) *WebSocketHandler {
+	h := &WebSocketHandler{}
+	if history == nil {
+		return h
+	}
+	h.history = make(chan []byte, historyQueueSize)
+	go recordHistory(history, h.history)
+	return h
+}
+
 // HandleWebSocket establece la conexión WebSocket
-func HandleWebSocket(c echo.Context) error {
+func (h *WebSocketHandler) HandleWebSocket(c echo.Context) error {
 	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
 	if err != nil {
 		return err
@@ -46,6 +70,7 @@ func HandleWebSocket(c echo.Context) error {
 			Type string `json:"type"`
 		}
 		if err := json.Unmarshal(msg, &envelope); err == nil {
+			msg = stripLyricsChords(envelope.Type, msg)
 			switch envelope.Type {
 			case "sceneUpdate":
 				manager.SetLastScene(msg)
@@ -63,5 +88,66 @@ func HandleWebSocket(c echo.Context) error {
 		}
 
 		manager.BroadcastMessage(msg)
+		if h.history != nil {
+			select {
+			case h.history <- msg:
+			default:
+				log.Warnf("live history queue full, message not recorded")
+			}
+		}
+	}
+}
+
+// recordHistory writes the queued messages to the history in the order they
+// came. It runs outside any request context so a scene still ends in the
+// history when the client that showed it disconnects.
+func recordHistory(history actions.LiveHistoryActionInterface, queue <-chan []byte) {
+	for msg := range queue {
+		if err := history.RecordMessage(context.Background(), msg); err != nil {
+			log.Warnf("record live history failed err=%v", err)
+		}
+	}
+}
+
+// stripLyricsChords removes the [chords] from the text of a lyrics scene, so
+// the screens show the lyrics alone whatever a client sends. Other messages
+// are relayed untouched.
+func stripLyricsChords(messageType string, msg []byte) []byte {
+	var message map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &message); err != nil {
+		return msg
+	}
+	switch messageType {
+	case "lyricsUpdate":
+		if !stripContentChords(message) {
+			return msg
+		}
+	case "sceneUpdate":
+		var scene map[string]json.RawMessage
+		if err := json.Unmarshal(message["scene"], &scene); err != nil || string(scene["type"]) != `"lyrics"` {
+			return msg
+		}
+		var payload map[string]json.RawMessage
+		if err := json.Unmarshal(scene["payload"], &payload); err != nil || !stripContentChords(payload) {
+			return msg
+		}
+		scene["payload"], _ = json.Marshal(payload)
+		message["scene"], _ = json.Marshal(scene)
+	default:
+		return msg
+	}
+	stripped, err := json.Marshal(message)
+	if err != nil {
+		return msg
+	}
+	return stripped
+}
+
+func stripContentChords(payload map[string]json.RawMessage) bool {
+	var content string
+	if err := json.Unmarshal(payload["content"], &content); err != nil || !songs.HasChords(content) {
+		return false
 	}
+	payload["content"], _ = json.Marshal(songs.StripChords(content))
+	return true
 }
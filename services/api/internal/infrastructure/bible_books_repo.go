@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"sort"
+)
+
+// ListBooks returns the books of a version in canonical order, with the
+// verse count of every chapter taken from chapters_verses.
+func (a *Database) ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error) {
+	rows, err := a.db.QueryContext(ctx, listBooksQuery, bibleVersion(version))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := []entities.BookInfo{}
+	positions := map[string]int{}
+	for rows.Next() {
+		var (
+			name, title    string
+			chapter, count int
+		)
+		if err := rows.Scan(&name, &title, &chapter, &count); err != nil {
+			return nil, err
+		}
+		position, ok := positions[name]
+		if !ok {
+			books = append(books, newBookInfo(name, title))
+			position = len(books) - 1
+			positions[name] = position
+		}
+		if chapter <= 0 {
+			continue
+		}
+		book := &books[position]
+		for len(book.Verses) < chapter {
+			book.Verses = append(book.Verses, 0)
+		}
+		book.Verses[chapter-1] = count
+		book.Chapters = len(book.Verses)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(books, func(i, j int) bool {
+		return books[i].Position < books[j].Position
+	})
+	return books, nil
+}
+
+// newBookInfo fills the catalog details of a book that do not depend on the
+// version. title is the name stored with the version and wins over
+// consts.Books when present.
+func newBookInfo(name string, title string) entities.BookInfo {
+	book := entities.BookInfo{
+		Book:         name,
+		Name:         title,
+		Abbreviation: consts.BookAbbreviations[name],
+		Aliases:      consts.BookAliases[name],
+		Testament:    consts.OldTestament,
+		Genre:        consts.BookGenres[name],
+		GenreName:    consts.Genres[consts.BookGenres[name]],
+		Verses:       []int{},
+	}
+	if book.Name == "" {
+		book.Name = consts.Books[name]
+	}
+	if book.Aliases == nil {
+		book.Aliases = []string{}
+	}
+	for i, slug := range consts.BookOrder {
+		if slug == name {
+			book.Position = i + 1
+			if i >= consts.OldTestamentBooks {
+				book.Testament = consts.NewTestament
+			}
+		}
+	}
+	for code, slug := range consts.OSISBooks {
+		if slug == name {
+			book.OSIS = code
+		}
+	}
+	for code, slug := range consts.USFMBooks {
+		if slug == name {
+			book.USFM = code
+		}
+	}
+	return book
+}
+
+const listBooksQuery = `SELECT b.name, COALESCE(b.title, ''), COALESCE(cv.chapter, 0), COALESCE(cv.number_verses, 0)
+						FROM books b
+						LEFT JOIN chapters_verses cv ON cv.bible_id = b.bible_id AND cv.book = b.name
+						WHERE b.bible_id = ?
+						ORDER BY b.id, cv.chapter`
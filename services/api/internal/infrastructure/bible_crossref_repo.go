@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+)
+
+// ImportCrossReferences replaces the stored cross references with refs in
+// one transaction and returns how many were stored.
+func (a *Database) ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error) {
+	txn, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer txn.Rollback()
+
+	if _, err := txn.ExecContext(ctx, deleteCrossReferencesQuery); err != nil {
+		return 0, fmt.Errorf("clear cross references: %w", err)
+	}
+
+	stmt, err := txn.PrepareContext(ctx, insertCrossReferenceQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	for _, ref := range refs {
+		target := ref.Target
+		if _, err := stmt.ExecContext(ctx, ref.Book, ref.Chapter, ref.Verse,
+			target.Book, target.StartChapter, target.StartVerse, target.EndChapter, target.EndVerse, ref.Votes); err != nil {
+			return 0, fmt.Errorf("insert cross reference %s %d:%d: %w", ref.Book, ref.Chapter, ref.Verse, err)
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, err
+	}
+	return len(refs), nil
+}
+
+// GetCrossReferences returns the links of one verse in the standard
+// numbering, most voted first and then in file order.
+func (a *Database) GetCrossReferences(ctx context.Context, book string, chapter int, verse int, limit int) ([]entities.CrossReference, error) {
+	rows, err := a.db.QueryContext(ctx, crossReferencesQuery, book, chapter, verse, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refs := []entities.CrossReference{}
+	for rows.Next() {
+		ref := entities.CrossReference{Book: book, Chapter: chapter, Verse: verse}
+		target := &ref.Target
+		if err := rows.Scan(&target.Book, &target.StartChapter, &target.StartVerse, &target.EndChapter, &target.EndVerse, &ref.Votes); err != nil {
+			return nil, err
+		}
+		target.BookName = consts.Books[target.Book]
+		target.Reference = bibleref.Format(*target)
+		refs = append(refs, ref)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+const (
+	deleteCrossReferencesQuery = `DELETE FROM cross_references`
+	insertCrossReferenceQuery  = `INSERT INTO cross_references
+									(book, chapter, verse, to_book, to_start_chapter, to_start_verse, to_end_chapter, to_end_verse, votes)
+									VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	crossReferencesQuery = `SELECT to_book, to_start_chapter, to_start_verse, to_end_chapter, to_end_verse, votes
+							FROM cross_references
+							WHERE book = ? AND chapter = ? AND verse = ?
+							ORDER BY votes DESC, id
+							LIMIT ?`
+)
@@ -0,0 +1,54 @@
+package infrastructure_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/crossref"
+	"services/api/internal/infrastructure"
+	"strings"
+	"testing"
+)
+
+func TestDatabase_GetCrossReferences(t *testing.T) {
+	t.Run("should return the links of a verse most voted first", func(t *testing.T) {
+		repo := infrastructure.NewBibleRepo(setupTestDB(t))
+		ctx := context.Background()
+		refs, _, err := crossref.Parse(strings.NewReader("Gen.1.1\tProv.8.22-Prov.8.30\t59\n" +
+			"Gen.1.1\tJohn.1.1-John.1.3\t231\n" +
+			"Gen.1.1\tHeb.11.3\t59\n" +
+			"Gen.1.2\tPs.104.30\t12\n"))
+		require.NoError(t, err)
+		imported, err := repo.ImportCrossReferences(ctx, refs)
+		require.NoError(t, err)
+		require.Equal(t, 4, imported)
+
+		found, err := repo.GetCrossReferences(ctx, "genesis", 1, 1, 10)
+
+		require.NoError(t, err)
+		require.Len(t, found, 3)
+		assert.Equal(t, "Juan 1:1-3", found[0].Target.Reference)
+		assert.Equal(t, "Proverbios 8:22-30", found[1].Target.Reference)
+		assert.Equal(t, "Hebreos 11:3", found[2].Target.Reference)
+		assert.Equal(t, 231, found[0].Votes)
+	})
+
+	t.Run("should replace the stored links on import and honour the limit", func(t *testing.T) {
+		repo := infrastructure.NewBibleRepo(setupTestDB(t))
+		ctx := context.Background()
+		first, _, err := crossref.Parse(strings.NewReader("Gen.1.1\tJohn.1.1\t10\nGen.1.1\tHeb.11.3\t5\n"))
+		require.NoError(t, err)
+		_, err = repo.ImportCrossReferences(ctx, first)
+		require.NoError(t, err)
+		second, _, err := crossref.Parse(strings.NewReader("Gen.1.1\tPs.33.6\t8\nGen.1.1\tCol.1.16\t4\n"))
+		require.NoError(t, err)
+		_, err = repo.ImportCrossReferences(ctx, second)
+		require.NoError(t, err)
+
+		found, err := repo.GetCrossReferences(ctx, "genesis", 1, 1, 1)
+
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, "Salmos 33:6", found[0].Target.Reference)
+	})
+}
@@ -0,0 +1,227 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+)
+
+// ImportBible stores a parsed bible as a new version. Everything runs in one
+// transaction so a failure leaves no partial version behind.
+func (a *Database) ImportBible(ctx context.Context, bible entities.BibleImport) (*entities.Bible, error) {
+	if bible.Name == "" {
+		return nil, errors.New("bible name is required")
+	}
+	if len(bible.Books) == 0 {
+		return nil, errors.New("bible has no books")
+	}
+
+	txn, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	versification := bible.Versification
+	if versification == "" {
+		versification = consts.StandardVersification
+	}
+
+	result, err := txn.ExecContext(ctx, insertBibleQuery, bible.Name, bible.Abbreviation, bible.Language, bible.License, bible.Attribution, versification)
+	if err != nil {
+		return nil, fmt.Errorf("insert bible: %w", err)
+	}
+	bibleID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	stmts, err := prepareImportStatements(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	defer stmts.close()
+
+	summary := entities.Bible{
+		ID:            int(bibleID),
+		Name:          bible.Name,
+		Abbreviation:  bible.Abbreviation,
+		Language:      bible.Language,
+		License:       bible.License,
+		Attribution:   bible.Attribution,
+		Versification: versification,
+	}
+
+	for _, book := range bible.Books {
+		result, err := stmts.book.ExecContext(ctx, bibleID, book.Name, book.Title)
+		if err != nil {
+			return nil, fmt.Errorf("insert book %s: %w", book.Name, err)
+		}
+		bookID, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		summary.Books++
+
+		for _, chapter := range book.Chapters {
+			title := fmt.Sprintf("%s %d", book.Title, chapter.Index)
+			result, err := stmts.chapter.ExecContext(ctx, bookID, chapter.Index, chapter.Research, title)
+			if err != nil {
+				return nil, fmt.Errorf("insert chapter %s %d: %w", book.Name, chapter.Index, err)
+			}
+			chapterID, err := result.LastInsertId()
+			if err != nil {
+				return nil, err
+			}
+
+			numberVerses := 0
+			for _, verse := range chapter.Verses {
+				// A bridge such as 4-5 is stored once under its first verse;
+				// last lets a lookup of 5 find it.
+				if _, err := stmts.verse.ExecContext(ctx, chapterID, verse.Research, verse.Index, verse.Last, verse.Text); err != nil {
+					return nil, fmt.Errorf("insert verse %s %d:%d: %w", book.Name, chapter.Index, verse.Index, err)
+				}
+				numberVerses = max(numberVerses, verse.Index, verse.Last)
+				summary.Verses += max(verse.Last-verse.Index, 0) + 1
+			}
+
+			if _, err := stmts.chaptersVerses.ExecContext(ctx, bibleID, book.Name, chapter.Index, numberVerses); err != nil {
+				return nil, fmt.Errorf("insert chapter size %s %d: %w", book.Name, chapter.Index, err)
+			}
+		}
+	}
+
+	if err := refreshSearchVocabulary(ctx, txn, bibleID); err != nil {
+		return nil, fmt.Errorf("index vocabulary: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// ValidateBibleImport compares a parsed bible with the versification of the
+// default version and lists the books, chapters and verses it lacks.
+func (a *Database) ValidateBibleImport(ctx context.Context, bible entities.BibleImport) (*entities.ImportValidation, error) {
+	rows, err := a.db.QueryContext(ctx, expectedVersificationQuery, defaultBibleVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	expected := map[string]map[int]int{}
+	for rows.Next() {
+		var book string
+		var chapter, numberVerses int
+		if err := rows.Scan(&book, &chapter, &numberVerses); err != nil {
+			return nil, err
+		}
+		if expected[book] == nil {
+			expected[book] = map[int]int{}
+		}
+		expected[book][chapter] = numberVerses
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	imported := map[string]map[int]map[int]bool{}
+	for _, book := range bible.Books {
+		chapters := map[int]map[int]bool{}
+		for _, chapter := range book.Chapters {
+			verses := map[int]bool{}
+			for _, verse := range chapter.Verses {
+				for index := verse.Index; index <= max(verse.Index, verse.Last); index++ {
+					verses[index] = true
+				}
+			}
+			chapters[chapter.Index] = verses
+		}
+		imported[book.Name] = chapters
+	}
+
+	validation := &entities.ImportValidation{
+		MissingBooks:    []string{},
+		MissingChapters: []entities.MissingChapter{},
+		MissingVerses:   []entities.MissingVerses{},
+	}
+	for _, book := range consts.BookOrder {
+		chapters, ok := expected[book]
+		if !ok {
+			continue
+		}
+		importedChapters, ok := imported[book]
+		if !ok {
+			validation.MissingBooks = append(validation.MissingBooks, book)
+			continue
+		}
+		for chapter := 1; chapter <= len(chapters); chapter++ {
+			verses, ok := importedChapters[chapter]
+			if !ok {
+				validation.MissingChapters = append(validation.MissingChapters, entities.MissingChapter{Book: book, Chapter: chapter})
+				continue
+			}
+			missing := []int{}
+			for verse := 1; verse <= chapters[chapter]; verse++ {
+				if !verses[verse] {
+					missing = append(missing, verse)
+				}
+			}
+			if len(missing) > 0 {
+				validation.MissingVerses = append(validation.MissingVerses, entities.MissingVerses{Book: book, Chapter: chapter, Verses: missing})
+			}
+		}
+	}
+
+	return validation, nil
+}
+
+type importStatements struct {
+	book           *sql.Stmt
+	chapter        *sql.Stmt
+	verse          *sql.Stmt
+	chaptersVerses *sql.Stmt
+}
+
+func prepareImportStatements(ctx context.Context, txn *sql.Tx) (*importStatements, error) {
+	stmts := &importStatements{}
+	var err error
+	if stmts.book, err = txn.PrepareContext(ctx, insertBookQuery); err != nil {
+		return nil, err
+	}
+	if stmts.chapter, err = txn.PrepareContext(ctx, insertChapterQuery); err != nil {
+		stmts.close()
+		return nil, err
+	}
+	if stmts.verse, err = txn.PrepareContext(ctx, insertVerseQuery); err != nil {
+		stmts.close()
+		return nil, err
+	}
+	if stmts.chaptersVerses, err = txn.PrepareContext(ctx, insertChaptersVersesQuery); err != nil {
+		stmts.close()
+		return nil, err
+	}
+	return stmts, nil
+}
+
+func (s *importStatements) close() {
+	for _, stmt := range []*sql.Stmt{s.book, s.chapter, s.verse, s.chaptersVerses} {
+		if stmt != nil {
+			_ = stmt.Close()
+		}
+	}
+}
+
+const (
+	expectedVersificationQuery = `SELECT book, chapter, number_verses FROM chapters_verses WHERE bible_id = ?`
+	insertBibleQuery           = `INSERT INTO bibles (version_name, abbreviation, language, license, attribution, versification) VALUES (?, ?, ?, ?, ?, ?)`
+	insertBookQuery            = `INSERT INTO books (bible_id, name, title) VALUES (?, ?, ?)`
+	insertChapterQuery         = `INSERT INTO chapters (book_id, "index", research, title) VALUES (?, ?, ?, ?)`
+	insertVerseQuery           = `INSERT INTO verses (chapter, research, "index", "last", content) VALUES (?, ?, ?, ?, ?)`
+	insertChaptersVersesQuery  = `INSERT INTO chapters_verses (bible_id, book, chapter, number_verses) VALUES (?, ?, ?, ?)`
+)
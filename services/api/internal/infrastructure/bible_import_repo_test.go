@@ -0,0 +1,72 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"services/api/domain/entities"
+	"services/api/internal/dbmigrate"
+	"services/api/internal/infrastructure"
+	"services/api/migrations"
+	"services/api/pkg/sqlite"
+	"testing"
+)
+
+func TestDatabase_ImportBible(t *testing.T) {
+	t.Run("should store a verse bridge once under its first verse", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := infrastructure.NewBibleRepo(db)
+		ctx := context.Background()
+
+		bible, err := repo.ImportBible(ctx, entities.BibleImport{
+			Name:         "Traducción de prueba",
+			Abbreviation: "TP",
+			Books: []entities.ImportBook{{
+				Name:  "juan",
+				Title: "Juan",
+				Chapters: []entities.ImportChapter{{
+					Index: 3,
+					Verses: []entities.ImportVerse{
+						{Index: 1, Text: "Había un hombre de los fariseos."},
+						{Index: 2, Text: "Este vino a Jesús de noche."},
+						{Index: 3, Text: "Respondió Jesús."},
+						{Index: 4, Last: 5, Research: "Nacer de nuevo", Text: "Nicodemo le dijo y Jesús respondió."},
+						{Index: 6, Text: "Lo que es nacido de la carne, carne es."},
+					},
+				}},
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 6, bible.Verses)
+
+		sizes, err := repo.GetChapterSizes(ctx, bible.ID, "juan")
+		require.NoError(t, err)
+		assert.Equal(t, map[int]int{3: 6}, sizes)
+
+		chapter, err := repo.GetBibleReferences(ctx, entities.RequestBible{Book: "juan", Chapter: 3, Version: bible.ID})
+		require.NoError(t, err)
+		require.Len(t, chapter.Verses, 5)
+		assert.Equal(t, entities.Verse{Research: "Nacer de nuevo", Index: 4, Last: 5, Text: "Nicodemo le dijo y Jesús respondió."}, chapter.Verses[3])
+		assert.Equal(t, 6, chapter.Verses[4].Index)
+
+		passage, err := repo.GetPassage(ctx, bible.ID, entities.ReferenceRange{Book: "juan", StartChapter: 3, StartVerse: 5, EndChapter: 3, EndVerse: 5})
+		require.NoError(t, err)
+		require.Len(t, passage, 1)
+		require.Len(t, passage[0].Verses, 1)
+		assert.Equal(t, 4, passage[0].Verses[0].Index)
+		assert.Equal(t, "Nicodemo le dijo y Jesús respondió.", passage[0].Verses[0].Text)
+	})
+}
+
+// setupTestDB opens a fresh database with every migration applied, as the
+// API leaves it at startup.
+func setupTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "bible.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, dbmigrate.Run(context.Background(), db, migrations.FS))
+	return db
+}
@@ -0,0 +1,298 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/searchquery"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// SearchVerses runs a parsed search over verses_fts, best matches first by
+// bm25, and marks the matched words of each verse in Snippet. The result
+// holds one page of matches together with the total hit count and how those
+// hits spread across books.
+func (a *Database) SearchVerses(ctx context.Context, query entities.SearchQuery, version int, limit int, offset int) (*entities.SearchResult, error) {
+	if limit <= 0 {
+		limit = 8
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	result := &entities.SearchResult{
+		Offset:  offset,
+		Limit:   limit,
+		Results: []entities.VerseMatch{},
+		Facets:  []entities.SearchFacet{},
+	}
+
+	match := compileSearchMatch(query)
+	if match == "" {
+		return result, nil
+	}
+
+	filter, args := buildSearchFilter(query, match, bibleVersion(version))
+
+	facets, err := a.searchFacets(ctx, filter, args)
+	if err != nil {
+		return nil, err
+	}
+	for _, facet := range facets {
+		result.Total += facet.Count
+	}
+	result.Facets = facets
+	if offset >= result.Total {
+		return result, nil
+	}
+
+	sqlQuery := searchVersesQuery + filter + ` ORDER BY bm25(verses_fts), b.id, c."index", v."index" LIMIT ? OFFSET ?`
+	rows, err := a.db.QueryContext(ctx, sqlQuery, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item entities.VerseMatch
+		if err := rows.Scan(&item.Book, &item.Chapter, &item.Verse, &item.Text, &item.Snippet); err != nil {
+			return nil, err
+		}
+		result.Results = append(result.Results, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if next := offset + len(result.Results); next < result.Total {
+		result.NextOffset = &next
+	}
+	return result, nil
+}
+
+// searchFacets counts the hits of the search per book, in canonical order.
+func (a *Database) searchFacets(ctx context.Context, filter string, args []interface{}) ([]entities.SearchFacet, error) {
+	rows, err := a.db.QueryContext(ctx, searchFacetsQuery+filter+` GROUP BY b.id, b.name ORDER BY b.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	facets := []entities.SearchFacet{}
+	for rows.Next() {
+		var facet entities.SearchFacet
+		if err := rows.Scan(&facet.Book, &facet.Count); err != nil {
+			return nil, err
+		}
+		facet.Name = consts.Books[facet.Book]
+		facets = append(facets, facet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
+
+const searchVersesQuery = `SELECT b.name, c."index", v."index", v.content,
+								snippet(verses_fts, 0, '<mark>', '</mark>', '…', 32)`
+
+const searchFacetsQuery = `SELECT b.name, COUNT(*)`
+
+const searchFromQuery = `
+							FROM verses_fts
+							INNER JOIN verses v ON v.id = verses_fts.rowid
+							INNER JOIN chapters c ON c.id = v.chapter
+							INNER JOIN books b ON b.id = c.book_id
+							WHERE verses_fts MATCH ? AND b.bible_id = ?`
+
+// buildSearchFilter returns the FROM and WHERE part shared by the match and
+// facet queries, with the book filters of the query, and its arguments. A
+// verse has to pass every filter.
+func buildSearchFilter(query entities.SearchQuery, match string, version int) (string, []interface{}) {
+	filter := searchFromQuery
+	args := []interface{}{match, version}
+
+	for _, books := range query.Books {
+		if len(books) == 0 {
+			continue
+		}
+		filter += ` AND b.name IN (?` + strings.Repeat(`, ?`, len(books)-1) + `)`
+		for _, book := range books {
+			args = append(args, book)
+		}
+	}
+	return filter, args
+}
+
+// GetSearchCandidates lists the words of the vocabulary of one version within
+// searchquery.MaxDistance edits of word, closest and most used first. With
+// prefix, words that start with word count as exact.
+func (a *Database) GetSearchCandidates(ctx context.Context, version int, word string, prefix bool, limit int) ([]entities.SearchCandidate, error) {
+	word = normalizeSearchTerm(word)
+	length := utf8.RuneCountInString(word)
+	maxDistance := searchquery.MaxDistance(word)
+	if length == 0 || limit <= 0 {
+		return []entities.SearchCandidate{}, nil
+	}
+
+	version = bibleVersion(version)
+	rows, err := a.db.QueryContext(ctx, searchVocabularyQuery,
+		version, length-maxDistance, length+maxDistance,
+		prefix, version, word, word+string(utf8.MaxRune))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := []entities.SearchCandidate{}
+	for rows.Next() {
+		var candidate entities.SearchCandidate
+		if err := rows.Scan(&candidate.Term, &candidate.Verses); err != nil {
+			return nil, err
+		}
+		if prefix && strings.HasPrefix(candidate.Term, word) {
+			candidate.Distance = 0
+		} else if candidate.Distance = searchquery.Distance(word, candidate.Term); candidate.Distance > maxDistance {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Distance != candidates[j].Distance {
+			return candidates[i].Distance < candidates[j].Distance
+		}
+		return candidates[i].Verses > candidates[j].Verses
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// searchVocabularyQuery reads the words of a version, already folded to
+// lower case without accents like verses_fts, whose length is within reach
+// or, for a prefix, that start with the word. Both halves run on an index
+// of search_vocabulary.
+const searchVocabularyQuery = `SELECT term, verses FROM search_vocabulary
+								WHERE bible_id = ? AND length BETWEEN ? AND ?
+								UNION
+								SELECT term, verses FROM search_vocabulary
+								WHERE ? AND bible_id = ? AND term >= ? AND term < ?`
+
+// RebuildSearchVocabulary fills search_vocabulary again from verses_fts for
+// every version. Verses written outside ImportBible, as tools/pg_to_sqlite
+// does, need it before the fuzzy search can see their words.
+func RebuildSearchVocabulary(ctx context.Context, db *sql.DB) error {
+	txn, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	if _, err := txn.ExecContext(ctx, `DELETE FROM search_vocabulary`); err != nil {
+		return err
+	}
+	if _, err := txn.ExecContext(ctx, fillSearchVocabularyQuery+` GROUP BY b.bible_id, i.term`); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+// refreshSearchVocabulary replaces the vocabulary of one version.
+func refreshSearchVocabulary(ctx context.Context, txn *sql.Tx, version int64) error {
+	if _, err := txn.ExecContext(ctx, `DELETE FROM search_vocabulary WHERE bible_id = ?`, version); err != nil {
+		return err
+	}
+	_, err := txn.ExecContext(ctx, fillSearchVocabularyQuery+` WHERE b.bible_id = ? GROUP BY b.bible_id, i.term`, version)
+	return err
+}
+
+// fillSearchVocabularyQuery counts, for each word of verses_fts, the verses
+// of each version that contain it.
+const fillSearchVocabularyQuery = `INSERT INTO search_vocabulary (bible_id, term, length, verses)
+									SELECT b.bible_id, i.term, length(i.term), COUNT(DISTINCT i.doc)
+									FROM verses_vocab_instance i
+									INNER JOIN verses v ON v.id = i.doc
+									INNER JOIN chapters c ON c.id = v.chapter
+									INNER JOIN books b ON b.id = c.book_id`
+
+// compileSearchMatch writes the query as an FTS5 expression:
+//
+//	("no temas") AND ("gracia"* OR "misericordia"*) NOT "muerte"*
+//
+// Words are folded like the index (verses_fts drops accents and case), and
+// every word is quoted so user input cannot inject FTS5 syntax.
+func compileSearchMatch(query entities.SearchQuery) string {
+	groups := make([]string, 0, len(query.Groups))
+	for _, group := range query.Groups {
+		terms := make([]string, 0, len(group))
+		for _, term := range group {
+			if compiled := compileSearchTerm(term); compiled != "" {
+				terms = append(terms, compiled)
+			}
+		}
+		if len(terms) > 0 {
+			groups = append(groups, "("+strings.Join(terms, " OR ")+")")
+		}
+	}
+	if len(groups) == 0 {
+		return ""
+	}
+
+	match := strings.Join(groups, " AND ")
+	for _, term := range query.Excluded {
+		if compiled := compileSearchTerm(term); compiled != "" {
+			match += " NOT " + compiled
+		}
+	}
+	return match
+}
+
+func compileSearchTerm(term entities.SearchTerm) string {
+	words := make([]string, 0, len(term.Words))
+	for _, word := range term.Words {
+		word = strings.ReplaceAll(normalizeSearchTerm(word), `"`, "")
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	if len(words) == 0 {
+		return ""
+	}
+	compiled := `"` + strings.Join(words, " ") + `"`
+	if term.Prefix {
+		compiled += "*"
+	}
+	return compiled
+}
+
+var searchTermReplacer = strings.NewReplacer(
+	"á", "a",
+	"é", "e",
+	"í", "i",
+	"ó", "o",
+	"ú", "u",
+	"ü", "u",
+	"ñ", "n",
+)
+
+func normalizeSearchTerm(term string) string {
+	normalized := strings.ToLower(strings.TrimSpace(term))
+	if normalized == "" {
+		return ""
+	}
+	return searchTermReplacer.Replace(normalized)
+}
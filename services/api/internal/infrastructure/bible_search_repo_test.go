@@ -0,0 +1,118 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"services/api/internal/searchquery"
+	"testing"
+)
+
+func TestDatabase_SearchVerses(t *testing.T) {
+	t.Run("should keep the index in step with inserts, edits and deletes", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := infrastructure.NewBibleRepo(db)
+
+		chapterID := seedVerses(t, db, "genesis", 1, "En el principio creó Dios los cielos y la tierra.")
+		assert.Equal(t, 1, searchTotal(t, repo, "principio"))
+
+		_, err := db.Exec(`UPDATE verses SET content = 'Y dijo Dios: Sea la luz.' WHERE chapter = ? AND "index" = 1`, chapterID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, searchTotal(t, repo, "principio"))
+		assert.Equal(t, 1, searchTotal(t, repo, "luz"))
+
+		_, err = db.Exec(`DELETE FROM verses WHERE chapter = ?`, chapterID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, searchTotal(t, repo, "luz"))
+	})
+}
+
+func TestDatabase_SearchVerses_Filters(t *testing.T) {
+	t.Run("should require a verse to pass every filter", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := infrastructure.NewBibleRepo(db)
+		seedVerses(t, db, "genesis", 1, "Y vio Dios que la luz era buena.")
+		seedVerses(t, db, "juan", 1, "Aquella luz verdadera, que alumbra a todo hombre.")
+		seedVerses(t, db, "romanos", 13, "Vistámonos las armas de la luz.")
+
+		assert.Equal(t, 3, searchTotal(t, repo, "luz"))
+		assert.Equal(t, 2, searchTotal(t, repo, "luz nt:"))
+		assert.Equal(t, 1, searchTotal(t, repo, "luz nt: libro:juan"))
+		assert.Equal(t, 0, searchTotal(t, repo, "luz at: libro:juan"))
+
+		result, err := repo.SearchVerses(context.Background(), mustParseSearch(t, "luz libro:genesis-juan nt:"), 1, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "juan", result.Results[0].Book)
+	})
+}
+
+func TestDatabase_GetSearchCandidates(t *testing.T) {
+	t.Run("should only offer words of the searched version", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := infrastructure.NewBibleRepo(db)
+		ctx := context.Background()
+		seedVerses(t, db, "genesis", 1, "Y creó Dios al hombre.")
+		require.NoError(t, infrastructure.RebuildSearchVocabulary(ctx, db))
+		bible, err := repo.ImportBible(ctx, entities.BibleImport{
+			Name: "Traducción de prueba",
+			Books: []entities.ImportBook{{Name: "genesis", Title: "Génesis", Chapters: []entities.ImportChapter{{
+				Index:  1,
+				Verses: []entities.ImportVerse{{Index: 1, Text: "Y creó Dios al hambre."}},
+			}}}},
+		})
+		require.NoError(t, err)
+
+		bundled, err := repo.GetSearchCandidates(ctx, 1, "hmbre", false, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hombre"}, candidateTerms(bundled))
+
+		imported, err := repo.GetSearchCandidates(ctx, bible.ID, "hmbre", false, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hambre"}, candidateTerms(imported))
+
+		prefixed, err := repo.GetSearchCandidates(ctx, bible.ID, "ham", true, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hambre"}, candidateTerms(prefixed))
+	})
+}
+
+func candidateTerms(candidates []entities.SearchCandidate) []string {
+	terms := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		terms = append(terms, candidate.Term)
+	}
+	return terms
+}
+
+// seedVerses writes verses 1, 2, ... of a chapter of the bundled version,
+// whose books and chapters come with the migrations but not its text.
+func seedVerses(t *testing.T, db *sql.DB, book string, chapter int, texts ...string) int {
+	t.Helper()
+	var chapterID int
+	err := db.QueryRow(`SELECT c.id FROM chapters c INNER JOIN books b ON b.id = c.book_id
+		WHERE b.bible_id = 1 AND b.name = ? AND c."index" = ?`, book, chapter).Scan(&chapterID)
+	require.NoError(t, err)
+	for i, text := range texts {
+		_, err := db.Exec(`INSERT INTO verses (chapter, research, "index", content) VALUES (?, '', ?, ?)`, chapterID, i+1, text)
+		require.NoError(t, err)
+	}
+	return chapterID
+}
+
+func searchTotal(t *testing.T, repo infrastructure.DatabaseGetter, input string) int {
+	t.Helper()
+	result, err := repo.SearchVerses(context.Background(), mustParseSearch(t, input), 1, 10, 0)
+	require.NoError(t, err)
+	return result.Total
+}
+
+func mustParseSearch(t *testing.T, input string) entities.SearchQuery {
+	t.Helper()
+	query, err := searchquery.Parse(input)
+	require.NoError(t, err)
+	return query
+}
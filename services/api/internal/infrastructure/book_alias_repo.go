@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"services/api/domain/entities"
+)
+
+type BookAliasRepository interface {
+	ListBookAliases(ctx context.Context, book string) ([]entities.BookAlias, error)
+	AddBookAlias(ctx context.Context, alias entities.BookAlias, key string) (*entities.BookAlias, error)
+	DeleteBookAlias(ctx context.Context, id int64) error
+}
+
+type BookAliasRepo struct {
+	db *sql.DB
+}
+
+func NewBookAliasRepo(db *sql.DB) BookAliasRepository {
+	return &BookAliasRepo{db: db}
+}
+
+// ListBookAliases returns the aliases of one book, or of every book when
+// book is empty.
+func (r *BookAliasRepo) ListBookAliases(ctx context.Context, book string) ([]entities.BookAlias, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, book, alias, language, custom FROM book_aliases
+										  WHERE ? = '' OR book = ? ORDER BY book, custom, id`, book, book)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := []entities.BookAlias{}
+	for rows.Next() {
+		var alias entities.BookAlias
+		if err := rows.Scan(&alias.ID, &alias.Book, &alias.Alias, &alias.Language, &alias.Custom); err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
+
+// AddBookAlias stores a custom alias. key is the folded alias, unique
+// across the table.
+func (r *BookAliasRepo) AddBookAlias(ctx context.Context, alias entities.BookAlias, key string) (*entities.BookAlias, error) {
+	result, err := r.db.ExecContext(ctx, `INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES (?, ?, ?, ?, 1)`,
+		alias.Book, alias.Alias, key, alias.Language)
+	if err != nil {
+		return nil, err
+	}
+	alias.ID, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	alias.Custom = true
+	return &alias, nil
+}
+
+// DeleteBookAlias removes a custom alias; sql.ErrNoRows when there is no
+// custom alias with that id. Seeded aliases cannot be removed.
+func (r *BookAliasRepo) DeleteBookAlias(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM book_aliases WHERE id = ? AND custom = 1`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"services/api/domain/entities"
+	"time"
+)
+
+//go:generate mockgen -source=./collection_repo.go -destination=./mocks/collection_repo.go -package=mocks
+
+type CollectionRepository interface {
+	ListCollections(ctx context.Context) ([]entities.VerseCollectionSummary, error)
+	GetCollection(ctx context.Context, id string) (*entities.VerseCollection, error)
+	UpsertCollection(ctx context.Context, payload entities.VerseCollectionPayload) (*entities.VerseCollection, error)
+	DeleteCollection(ctx context.Context, id string) error
+}
+
+type CollectionRepo struct {
+	db *sql.DB
+}
+
+func NewCollectionRepo(db *sql.DB) CollectionRepository {
+	return &CollectionRepo{db: db}
+}
+
+func (r *CollectionRepo) ListCollections(ctx context.Context) ([]entities.VerseCollectionSummary, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT c.id, c.name, c.updated_at,
+												(SELECT COUNT(*) FROM verse_collection_items i WHERE i.collection_id = c.id)
+										   FROM verse_collections c ORDER BY c.updated_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []entities.VerseCollectionSummary{}
+	for rows.Next() {
+		var item entities.VerseCollectionSummary
+		if err := rows.Scan(&item.ID, &item.Name, &item.UpdatedAt, &item.Items); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetCollection returns one collection with its items in order;
+// sql.ErrNoRows when it does not exist.
+func (r *CollectionRepo) GetCollection(ctx context.Context, id string) (*entities.VerseCollection, error) {
+	var collection entities.VerseCollection
+	err := r.db.QueryRowContext(ctx, `SELECT id, name, description, created_at, updated_at FROM verse_collections WHERE id = ?`, id).
+		Scan(&collection.ID, &collection.Name, &collection.Description, &collection.CreatedAt, &collection.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT reference, note FROM verse_collection_items WHERE collection_id = ? ORDER BY position`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collection.Items = []entities.CollectionItem{}
+	for rows.Next() {
+		var item entities.CollectionItem
+		if err := rows.Scan(&item.Reference, &item.Note); err != nil {
+			return nil, err
+		}
+		collection.Items = append(collection.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// UpsertCollection saves the collection and replaces all of its items, so
+// the order of payload.Items becomes the stored order.
+func (r *CollectionRepo) UpsertCollection(ctx context.Context, payload entities.VerseCollectionPayload) (*entities.VerseCollection, error) {
+	if payload.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	_, err = txn.ExecContext(
+		ctx,
+		`INSERT INTO verse_collections (id, name, description, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?)
+         ON CONFLICT(id) DO UPDATE SET name = excluded.name, description = excluded.description, updated_at = excluded.updated_at`,
+		payload.ID,
+		payload.Name,
+		payload.Description,
+		now,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := txn.ExecContext(ctx, `DELETE FROM verse_collection_items WHERE collection_id = ?`, payload.ID); err != nil {
+		return nil, err
+	}
+	stmt, err := txn.PrepareContext(ctx, `INSERT INTO verse_collection_items (collection_id, position, reference, note) VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	for position, item := range payload.Items {
+		if _, err := stmt.ExecContext(ctx, payload.ID, position, item.Reference, item.Note); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return r.GetCollection(ctx, payload.ID)
+}
+
+func (r *CollectionRepo) DeleteCollection(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM verse_collections WHERE id = ?`, id)
+	return err
+}
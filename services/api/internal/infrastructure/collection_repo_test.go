@@ -0,0 +1,96 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"testing"
+)
+
+func TestCollectionRepo_UpsertCollection(t *testing.T) {
+	t.Run("should keep the items in the order they are sent", func(t *testing.T) {
+		repo := infrastructure.NewCollectionRepo(setupTestDB(t))
+
+		collection, err := repo.UpsertCollection(context.Background(), entities.VerseCollectionPayload{
+			ID:   "sermon",
+			Name: "Sermón del domingo",
+			Items: []entities.CollectionItem{
+				{Reference: "Romanos 8:28", Note: "Introducción"},
+				{Reference: "Juan 3:16"},
+				{Reference: "Génesis 1:1"},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Sermón del domingo", collection.Name)
+		assert.Equal(t, []string{"Romanos 8:28", "Juan 3:16", "Génesis 1:1"}, collectionReferences(collection.Items))
+		assert.Equal(t, "Introducción", collection.Items[0].Note)
+	})
+
+	t.Run("should replace every item on update", func(t *testing.T) {
+		repo := infrastructure.NewCollectionRepo(setupTestDB(t))
+		ctx := context.Background()
+		_, err := repo.UpsertCollection(ctx, entities.VerseCollectionPayload{
+			ID:    "sermon",
+			Name:  "Borrador",
+			Items: []entities.CollectionItem{{Reference: "Romanos 8:28"}, {Reference: "Juan 3:16"}, {Reference: "Salmos 23"}},
+		})
+		require.NoError(t, err)
+
+		collection, err := repo.UpsertCollection(ctx, entities.VerseCollectionPayload{
+			ID:    "sermon",
+			Name:  "Sermón del domingo",
+			Items: []entities.CollectionItem{{Reference: "Salmos 23"}, {Reference: "Romanos 8:28"}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Sermón del domingo", collection.Name)
+		assert.Equal(t, []string{"Salmos 23", "Romanos 8:28"}, collectionReferences(collection.Items))
+
+		summaries, err := repo.ListCollections(ctx)
+		require.NoError(t, err)
+		require.Len(t, summaries, 1)
+		assert.Equal(t, 2, summaries[0].Items)
+	})
+
+	t.Run("should require a name", func(t *testing.T) {
+		repo := infrastructure.NewCollectionRepo(setupTestDB(t))
+
+		_, err := repo.UpsertCollection(context.Background(), entities.VerseCollectionPayload{ID: "sermon"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCollectionRepo_DeleteCollection(t *testing.T) {
+	t.Run("should delete the collection with its items", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := infrastructure.NewCollectionRepo(db)
+		ctx := context.Background()
+		_, err := repo.UpsertCollection(ctx, entities.VerseCollectionPayload{
+			ID:    "sermon",
+			Name:  "Sermón del domingo",
+			Items: []entities.CollectionItem{{Reference: "Juan 3:16"}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteCollection(ctx, "sermon"))
+
+		_, err = repo.GetCollection(ctx, "sermon")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		var items int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM verse_collection_items`).Scan(&items))
+		assert.Zero(t, items)
+	})
+}
+
+func collectionReferences(items []entities.CollectionItem) []string {
+	references := make([]string, 0, len(items))
+	for _, item := range items {
+		references = append(references, item.Reference)
+	}
+	return references
+}
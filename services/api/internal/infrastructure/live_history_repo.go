@@ -0,0 +1,109 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"services/api/domain/entities"
+)
+
+//go:generate mockgen -source=./live_history_repo.go -destination=./mocks/live_history_repo.go -package=mocks
+
+type LiveHistoryRepository interface {
+	StartLiveEntry(ctx context.Context, entry entities.LiveHistoryEntry) (int64, error)
+	FinishLiveEntry(ctx context.Context, id int64, endedAt string, duration int, slides int) error
+	CloseOpenLiveEntries(ctx context.Context) error
+	ListLiveHistory(ctx context.Context, from string, to string) ([]entities.LiveHistoryEntry, error)
+	ListLiveHistoryDays(ctx context.Context) ([]entities.LiveHistoryDay, error)
+}
+
+type LiveHistoryRepo struct {
+	db *sql.DB
+}
+
+func NewLiveHistoryRepo(db *sql.DB) LiveHistoryRepository {
+	return &LiveHistoryRepo{db: db}
+}
+
+// StartLiveEntry stores a scene that just went live and returns its id.
+func (r *LiveHistoryRepo) StartLiveEntry(ctx context.Context, entry entities.LiveHistoryEntry) (int64, error) {
+	result, err := r.db.ExecContext(ctx, insertLiveEntryQuery,
+		entry.StartedAt, entry.Type, entry.Reference, entry.SongID, entry.Title, entry.Slides, entry.Source)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// FinishLiveEntry records when a scene left the screen.
+func (r *LiveHistoryRepo) FinishLiveEntry(ctx context.Context, id int64, endedAt string, duration int, slides int) error {
+	_, err := r.db.ExecContext(ctx, finishLiveEntryQuery, endedAt, duration, slides, id)
+	return err
+}
+
+// CloseOpenLiveEntries ends the entries a previous run left open. When the
+// scene left the screen is unknown, so they end when they started, without
+// a duration, rather than counting the time the app was down.
+func (r *LiveHistoryRepo) CloseOpenLiveEntries(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, closeOpenLiveEntriesQuery)
+	return err
+}
+
+// ListLiveHistory returns the entries started in [from, to), oldest first.
+func (r *LiveHistoryRepo) ListLiveHistory(ctx context.Context, from string, to string) ([]entities.LiveHistoryEntry, error) {
+	rows, err := r.db.QueryContext(ctx, listLiveHistoryQuery, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []entities.LiveHistoryEntry{}
+	for rows.Next() {
+		var item entities.LiveHistoryEntry
+		if err := rows.Scan(&item.ID, &item.StartedAt, &item.EndedAt, &item.Duration, &item.Type,
+			&item.Reference, &item.SongID, &item.Title, &item.Slides, &item.Source); err != nil {
+			return nil, err
+		}
+		entries = append(entries, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ListLiveHistoryDays lists the local days that have history, newest first.
+func (r *LiveHistoryRepo) ListLiveHistoryDays(ctx context.Context) ([]entities.LiveHistoryDay, error) {
+	rows, err := r.db.QueryContext(ctx, listLiveHistoryDaysQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []entities.LiveHistoryDay{}
+	for rows.Next() {
+		var item entities.LiveHistoryDay
+		if err := rows.Scan(&item.Date, &item.Entries, &item.Duration); err != nil {
+			return nil, err
+		}
+		days = append(days, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+const (
+	insertLiveEntryQuery = `INSERT INTO live_history (started_at, type, reference, song_id, title, slides, source)
+							VALUES (?, ?, ?, ?, ?, ?, ?)`
+	finishLiveEntryQuery      = `UPDATE live_history SET ended_at = ?, duration_seconds = ?, slides = ? WHERE id = ?`
+	closeOpenLiveEntriesQuery = `UPDATE live_history SET ended_at = started_at, duration_seconds = 0 WHERE ended_at = ''`
+	listLiveHistoryQuery      = `SELECT id, started_at, ended_at, duration_seconds, type, reference, song_id, title, slides, source
+							FROM live_history
+							WHERE started_at >= ? AND started_at < ?
+							ORDER BY started_at, id`
+	listLiveHistoryDaysQuery = `SELECT date(started_at, 'localtime') AS day, COUNT(*), COALESCE(SUM(duration_seconds), 0)
+								FROM live_history
+								GROUP BY day
+								ORDER BY day DESC`
+)
@@ -0,0 +1,33 @@
+package infrastructure_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"testing"
+)
+
+func TestLiveHistoryRepo_CloseOpenLiveEntries(t *testing.T) {
+	t.Run("should end the entries left open without a duration", func(t *testing.T) {
+		repo := infrastructure.NewLiveHistoryRepo(setupTestDB(t))
+		ctx := context.Background()
+
+		finished, err := repo.StartLiveEntry(ctx, entities.LiveHistoryEntry{StartedAt: "2026-10-11T15:00:00Z", Type: "verse", Reference: "Juan 3:16", Slides: 1})
+		require.NoError(t, err)
+		require.NoError(t, repo.FinishLiveEntry(ctx, finished, "2026-10-11T15:02:00Z", 120, 1))
+		_, err = repo.StartLiveEntry(ctx, entities.LiveHistoryEntry{StartedAt: "2026-10-11T15:02:00Z", Type: "lyrics", Title: "Sublime gracia", Slides: 1})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.CloseOpenLiveEntries(ctx))
+
+		entries, err := repo.ListLiveHistory(ctx, "2026-10-11T00:00:00Z", "2026-10-12T00:00:00Z")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "2026-10-11T15:02:00Z", entries[0].EndedAt)
+		assert.Equal(t, 120, entries[0].Duration)
+		assert.Equal(t, "2026-10-11T15:02:00Z", entries[1].EndedAt)
+		assert.Equal(t, 0, entries[1].Duration)
+	})
+}
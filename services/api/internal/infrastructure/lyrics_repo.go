@@ -7,11 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"services/api/domain/entities"
+	"strings"
 	"time"
 )
 
+//go:generate mockgen -source=./lyrics_repo.go -destination=./mocks/lyrics_repo.go -package=mocks
+
 type LyricsRepository interface {
-	ListSongs(ctx context.Context) ([]entities.LyricsSongSummary, error)
+	ListSongs(ctx context.Context, filter entities.RequestLyricsSongs) ([]entities.LyricsSongSummary, error)
+	ListTags(ctx context.Context) ([]entities.LyricsTag, error)
 	GetSong(ctx context.Context, id string) (*entities.LyricsSong, error)
 	UpsertSong(ctx context.Context, payload entities.LyricsSongPayload) (*entities.LyricsSong, error)
 	DeleteSong(ctx context.Context, id string) error
@@ -25,8 +29,50 @@ func NewLyricsRepo(db *sql.DB) LyricsRepository {
 	return &LyricsRepo{db: db}
 }
 
-func (r *LyricsRepo) ListSongs(ctx context.Context) ([]entities.LyricsSongSummary, error) {
-	rows, err := r.db.QueryContext(ctx, `SELECT id, title, updated_at FROM lyrics_songs ORDER BY updated_at DESC`)
+const (
+	listSongsQuery = `SELECT s.id, s.title, s.authors_json, s.ccli, s.song_key, s.tempo,
+							 (SELECT json_group_array(t.tag) FROM lyrics_song_tags t WHERE t.song_id = s.id), s.updated_at
+					  FROM lyrics_songs s`
+	songTagsQuery = `SELECT tag FROM lyrics_song_tags WHERE song_id = ? ORDER BY position`
+	listTagsQuery = `SELECT MIN(tag), COUNT(*) FROM lyrics_song_tags GROUP BY normalized ORDER BY normalized`
+)
+
+// ListSongs returns the songs that pass every filter, the last edited
+// first unless filter asks for another order. The title and author search
+// ignores case and accents, like the tags.
+func (r *LyricsRepo) ListSongs(ctx context.Context, filter entities.RequestLyricsSongs) ([]entities.LyricsSongSummary, error) {
+	conditions := []string{}
+	args := []interface{}{}
+	for _, word := range strings.Fields(normalizeSearchTerm(filter.Query)) {
+		conditions = append(conditions, `s.search_text LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(word)+"%")
+	}
+	if filter.Key != "" {
+		conditions = append(conditions, `s.song_key = ?`)
+		args = append(args, filter.Key)
+	}
+	for _, tag := range strings.Split(filter.Tag, ",") {
+		if tag = normalizeSearchTerm(tag); tag != "" {
+			conditions = append(conditions, `EXISTS (SELECT 1 FROM lyrics_song_tags t WHERE t.song_id = s.id AND t.normalized = ?)`)
+			args = append(args, tag)
+		}
+	}
+	if filter.TempoMin > 0 {
+		conditions = append(conditions, `s.tempo >= ?`)
+		args = append(args, filter.TempoMin)
+	}
+	if filter.TempoMax > 0 {
+		conditions = append(conditions, `s.tempo > 0 AND s.tempo <= ?`)
+		args = append(args, filter.TempoMax)
+	}
+
+	query := listSongsQuery
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY ` + songsOrder(filter.Sort, filter.Order)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -35,9 +81,16 @@ func (r *LyricsRepo) ListSongs(ctx context.Context) ([]entities.LyricsSongSummar
 	songs := []entities.LyricsSongSummary{}
 	for rows.Next() {
 		var item entities.LyricsSongSummary
-		if err := rows.Scan(&item.ID, &item.Title, &item.UpdatedAt); err != nil {
+		var authorsJSON, tagsJSON string
+		if err := rows.Scan(&item.ID, &item.Title, &authorsJSON, &item.CCLI, &item.Key, &item.Tempo, &tagsJSON, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
+		if err := json.Unmarshal([]byte(authorsJSON), &item.Authors); err != nil {
+			return nil, fmt.Errorf("invalid authors json: %w", err)
+		}
+		if err := json.Unmarshal([]byte(tagsJSON), &item.Tags); err != nil {
+			return nil, fmt.Errorf("invalid tags json: %w", err)
+		}
 		songs = append(songs, item)
 	}
 	if err := rows.Err(); err != nil {
@@ -46,12 +99,57 @@ func (r *LyricsRepo) ListSongs(ctx context.Context) ([]entities.LyricsSongSummar
 	return songs, nil
 }
 
+// songsOrder builds the ORDER BY of ListSongs. Titles sort by search_text,
+// which starts with the folded title, so "Él" sorts with the "e". Songs
+// without a key or tempo go last.
+func songsOrder(sort string, order string) string {
+	direction := "ASC"
+	if order == "desc" || (order == "" && (sort == "" || sort == "updated")) {
+		direction = "DESC"
+	}
+	switch sort {
+	case "title":
+		return "s.search_text " + direction
+	case "key":
+		return "s.song_key = '', s.song_key " + direction + ", s.search_text"
+	case "tempo":
+		return "s.tempo = 0, s.tempo " + direction + ", s.search_text"
+	default:
+		return "s.updated_at " + direction
+	}
+}
+
+// ListTags returns every tag of the library once, with the number of songs
+// that have it. Spellings that only differ in case or accents are one tag.
+func (r *LyricsRepo) ListTags(ctx context.Context) ([]entities.LyricsTag, error) {
+	rows, err := r.db.QueryContext(ctx, listTagsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []entities.LyricsTag{}
+	for rows.Next() {
+		var tag entities.LyricsTag
+		if err := rows.Scan(&tag.Name, &tag.Songs); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
 func (r *LyricsRepo) GetSong(ctx context.Context, id string) (*entities.LyricsSong, error) {
-	row := r.db.QueryRowContext(ctx, `SELECT id, title, lyrics, segments_json, settings_json, created_at, updated_at FROM lyrics_songs WHERE id = ?`, id)
+	row := r.db.QueryRowContext(ctx, `SELECT id, title, lyrics, segments_json, arrangements_json, default_arrangement, settings_json, authors_json, copyright, ccli, song_key, tempo, created_at, updated_at FROM lyrics_songs WHERE id = ?`, id)
 	var song entities.LyricsSong
 	var segmentsJSON string
+	var arrangementsJSON string
 	var settingsJSON string
-	if err := row.Scan(&song.ID, &song.Title, &song.Lyrics, &segmentsJSON, &settingsJSON, &song.CreatedAt, &song.UpdatedAt); err != nil {
+	var authorsJSON string
+	if err := row.Scan(&song.ID, &song.Title, &song.Lyrics, &segmentsJSON, &arrangementsJSON, &song.DefaultArrangement, &settingsJSON, &authorsJSON, &song.Copyright, &song.CCLI, &song.Key, &song.Tempo, &song.CreatedAt, &song.UpdatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, err
 		}
@@ -60,9 +158,32 @@ func (r *LyricsRepo) GetSong(ctx context.Context, id string) (*entities.LyricsSo
 	if err := json.Unmarshal([]byte(segmentsJSON), &song.Segments); err != nil {
 		return nil, fmt.Errorf("invalid segments json: %w", err)
 	}
+	if err := json.Unmarshal([]byte(arrangementsJSON), &song.Arrangements); err != nil {
+		return nil, fmt.Errorf("invalid arrangements json: %w", err)
+	}
 	if err := json.Unmarshal([]byte(settingsJSON), &song.Settings); err != nil {
 		return nil, fmt.Errorf("invalid settings json: %w", err)
 	}
+	if err := json.Unmarshal([]byte(authorsJSON), &song.Authors); err != nil {
+		return nil, fmt.Errorf("invalid authors json: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, songTagsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	song.Tags = []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		song.Tags = append(song.Tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &song, nil
 }
 
@@ -75,10 +196,26 @@ func (r *LyricsRepo) UpsertSong(ctx context.Context, payload entities.LyricsSong
 	if err != nil {
 		return nil, fmt.Errorf("marshal segments: %w", err)
 	}
+	if payload.Arrangements == nil {
+		payload.Arrangements = []entities.LyricsArrangement{}
+	}
+	arrangementsJSON, err := json.Marshal(payload.Arrangements)
+	if err != nil {
+		return nil, fmt.Errorf("marshal arrangements: %w", err)
+	}
 	settingsJSON, err := json.Marshal(payload.Settings)
 	if err != nil {
 		return nil, fmt.Errorf("marshal settings: %w", err)
 	}
+	if payload.Authors == nil {
+		payload.Authors = []string{}
+	}
+	authorsJSON, err := json.Marshal(payload.Authors)
+	if err != nil {
+		return nil, fmt.Errorf("marshal authors: %w", err)
+	}
+
+	searchText := normalizeSearchTerm(payload.Title + " " + strings.Join(payload.Authors, " ") + " " + payload.CCLI)
 
 	now := time.Now().UTC().Format(time.RFC3339)
 	createdAt := now
@@ -90,16 +227,33 @@ func (r *LyricsRepo) UpsertSong(ctx context.Context, payload entities.LyricsSong
 		}
 	}
 
-	_, err = r.db.ExecContext(
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	_, err = txn.ExecContext(
 		ctx,
-		`INSERT INTO lyrics_songs (id, title, lyrics, segments_json, settings_json, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, ?, ?, ?)
-		 ON CONFLICT(id) DO UPDATE SET title = excluded.title, lyrics = excluded.lyrics, segments_json = excluded.segments_json, settings_json = excluded.settings_json, updated_at = excluded.updated_at`,
+		`INSERT INTO lyrics_songs (id, title, lyrics, segments_json, arrangements_json, default_arrangement, settings_json, authors_json, copyright, ccli, song_key, tempo, search_text, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(id) DO UPDATE SET title = excluded.title, lyrics = excluded.lyrics, segments_json = excluded.segments_json,
+		 	arrangements_json = excluded.arrangements_json, default_arrangement = excluded.default_arrangement, settings_json = excluded.settings_json,
+		 	authors_json = excluded.authors_json, copyright = excluded.copyright, ccli = excluded.ccli, song_key = excluded.song_key, tempo = excluded.tempo,
+		 	search_text = excluded.search_text, updated_at = excluded.updated_at`,
 		payload.ID,
 		payload.Title,
 		payload.Lyrics,
 		string(segmentsJSON),
+		string(arrangementsJSON),
+		payload.DefaultArrangement,
 		string(settingsJSON),
+		string(authorsJSON),
+		payload.Copyright,
+		payload.CCLI,
+		payload.Key,
+		payload.Tempo,
+		searchText,
 		createdAt,
 		now,
 	)
@@ -107,6 +261,31 @@ func (r *LyricsRepo) UpsertSong(ctx context.Context, payload entities.LyricsSong
 		return nil, err
 	}
 
+	if _, err := txn.ExecContext(ctx, `DELETE FROM lyrics_song_tags WHERE song_id = ?`, payload.ID); err != nil {
+		return nil, err
+	}
+	stmt, err := txn.PrepareContext(ctx, `INSERT INTO lyrics_song_tags (song_id, position, tag, normalized) VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	seen := map[string]bool{}
+	for _, tag := range payload.Tags {
+		tag = strings.Join(strings.Fields(tag), " ")
+		normalized := normalizeSearchTerm(tag)
+		if normalized == "" || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		if _, err := stmt.ExecContext(ctx, payload.ID, len(seen)-1, tag, normalized); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
 	return r.GetSong(ctx, payload.ID)
 }
 
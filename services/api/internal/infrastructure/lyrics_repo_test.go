@@ -0,0 +1,73 @@
+package infrastructure_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"testing"
+)
+
+func TestLyricsRepo_ListSongs(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   entities.RequestLyricsSongs
+		expected []string
+	}{
+		{name: "should match a tag ignoring case and accents", filter: entities.RequestLyricsSongs{Tag: "ADORACION", Sort: "title"}, expected: []string{"Cuán grande es Él", "Santo"}},
+		{name: "should require every tag listed", filter: entities.RequestLyricsSongs{Tag: "adoración, Navidad"}, expected: []string{"Santo"}},
+		{name: "should keep the songs from a tempo up", filter: entities.RequestLyricsSongs{TempoMin: 80, Sort: "tempo"}, expected: []string{"Santo", "Alabaré"}},
+		{name: "should keep the songs up to a tempo, leaving out those without one", filter: entities.RequestLyricsSongs{TempoMax: 80, Sort: "tempo"}, expected: []string{"Cuán grande es Él", "Santo"}},
+		{name: "should keep the songs between two tempos", filter: entities.RequestLyricsSongs{TempoMin: 70, TempoMax: 100}, expected: []string{"Santo"}},
+		{name: "should combine the tags, the tempo and the key", filter: entities.RequestLyricsSongs{Tag: "adoracion", TempoMin: 60, Key: "Bb"}, expected: []string{"Cuán grande es Él"}},
+		{name: "should put the songs without a tempo last", filter: entities.RequestLyricsSongs{Sort: "tempo", Order: "desc"}, expected: []string{"Alabaré", "Santo", "Cuán grande es Él", "Sublime gracia"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := setupLyricsRepo(t)
+
+			found, err := repo.ListSongs(context.Background(), tt.filter)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, songTitles(found))
+		})
+	}
+}
+
+func TestLyricsRepo_ListTags(t *testing.T) {
+	t.Run("should count the songs of every tag, whatever its spelling", func(t *testing.T) {
+		repo := setupLyricsRepo(t)
+
+		tags, err := repo.ListTags(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.LyricsTag{{Name: "Adoración", Songs: 2}, {Name: "Alegría", Songs: 1}, {Name: "Navidad", Songs: 1}}, tags)
+	})
+}
+
+// setupLyricsRepo stores a few songs with tags and tempos in a fresh
+// database.
+func setupLyricsRepo(t *testing.T) infrastructure.LyricsRepository {
+	t.Helper()
+	repo := infrastructure.NewLyricsRepo(setupTestDB(t))
+	for _, song := range []entities.LyricsSongPayload{
+		{ID: "s1", Title: "Santo", Key: "D", Tempo: 80, Tags: []string{"Adoración", "Navidad"}},
+		{ID: "s2", Title: "Cuán grande es Él", Key: "Bb", Tempo: 66, Tags: []string{"adoracion"}},
+		{ID: "s3", Title: "Alabaré", Key: "D", Tempo: 120, Tags: []string{"Alegría"}},
+		{ID: "s4", Title: "Sublime gracia", Key: "G"},
+	} {
+		song.Segments = []entities.LyricsSegment{{ID: "v1", Title: "Verso 1", Content: song.Title, Kind: "verse"}}
+		_, err := repo.UpsertSong(context.Background(), song)
+		require.NoError(t, err)
+	}
+	return repo
+}
+
+func songTitles(found []entities.LyricsSongSummary) []string {
+	titles := make([]string, 0, len(found))
+	for _, song := range found {
+		titles = append(titles, song.Title)
+	}
+	return titles
+}
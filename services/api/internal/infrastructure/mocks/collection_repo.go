@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./collection_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entities "services/api/domain/entities"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCollectionRepository is a mock of CollectionRepository interface.
+type MockCollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionRepositoryMockRecorder
+}
+
+// MockCollectionRepositoryMockRecorder is the mock recorder for MockCollectionRepository.
+type MockCollectionRepositoryMockRecorder struct {
+	mock *MockCollectionRepository
+}
+
+// NewMockCollectionRepository creates a new mock instance.
+func NewMockCollectionRepository(ctrl *gomock.Controller) *MockCollectionRepository {
+	mock := &MockCollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockCollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionRepository) EXPECT() *MockCollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCollection mocks base method.
+func (m *MockCollectionRepository) DeleteCollection(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockCollectionRepositoryMockRecorder) DeleteCollection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockCollectionRepository)(nil).DeleteCollection), ctx, id)
+}
+
+// GetCollection mocks base method.
+func (m *MockCollectionRepository) GetCollection(ctx context.Context, id string) (*entities.VerseCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", ctx, id)
+	ret0, _ := ret[0].(*entities.VerseCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockCollectionRepositoryMockRecorder) GetCollection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockCollectionRepository)(nil).GetCollection), ctx, id)
+}
+
+// ListCollections mocks base method.
+func (m *MockCollectionRepository) ListCollections(ctx context.Context) ([]entities.VerseCollectionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollections", ctx)
+	ret0, _ := ret[0].([]entities.VerseCollectionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollections indicates an expected call of ListCollections.
+func (mr *MockCollectionRepositoryMockRecorder) ListCollections(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollections", reflect.TypeOf((*MockCollectionRepository)(nil).ListCollections), ctx)
+}
+
+// UpsertCollection mocks base method.
+func (m *MockCollectionRepository) UpsertCollection(ctx context.Context, payload entities.VerseCollectionPayload) (*entities.VerseCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCollection", ctx, payload)
+	ret0, _ := ret[0].(*entities.VerseCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCollection indicates an expected call of UpsertCollection.
+func (mr *MockCollectionRepositoryMockRecorder) UpsertCollection(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCollection", reflect.TypeOf((*MockCollectionRepository)(nil).UpsertCollection), ctx, payload)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./live_history_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entities "services/api/domain/entities"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLiveHistoryRepository is a mock of LiveHistoryRepository interface.
+type MockLiveHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveHistoryRepositoryMockRecorder
+}
+
+// MockLiveHistoryRepositoryMockRecorder is the mock recorder for MockLiveHistoryRepository.
+type MockLiveHistoryRepositoryMockRecorder struct {
+	mock *MockLiveHistoryRepository
+}
+
+// NewMockLiveHistoryRepository creates a new mock instance.
+func NewMockLiveHistoryRepository(ctrl *gomock.Controller) *MockLiveHistoryRepository {
+	mock := &MockLiveHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockLiveHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveHistoryRepository) EXPECT() *MockLiveHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CloseOpenLiveEntries mocks base method.
+func (m *MockLiveHistoryRepository) CloseOpenLiveEntries(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseOpenLiveEntries", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseOpenLiveEntries indicates an expected call of CloseOpenLiveEntries.
+func (mr *MockLiveHistoryRepositoryMockRecorder) CloseOpenLiveEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseOpenLiveEntries", reflect.TypeOf((*MockLiveHistoryRepository)(nil).CloseOpenLiveEntries), ctx)
+}
+
+// FinishLiveEntry mocks base method.
+func (m *MockLiveHistoryRepository) FinishLiveEntry(ctx context.Context, id int64, endedAt string, duration, slides int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishLiveEntry", ctx, id, endedAt, duration, slides)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishLiveEntry indicates an expected call of FinishLiveEntry.
+func (mr *MockLiveHistoryRepositoryMockRecorder) FinishLiveEntry(ctx, id, endedAt, duration, slides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishLiveEntry", reflect.TypeOf((*MockLiveHistoryRepository)(nil).FinishLiveEntry), ctx, id, endedAt, duration, slides)
+}
+
+// ListLiveHistory mocks base method.
+func (m *MockLiveHistoryRepository) ListLiveHistory(ctx context.Context, from, to string) ([]entities.LiveHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLiveHistory", ctx, from, to)
+	ret0, _ := ret[0].([]entities.LiveHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLiveHistory indicates an expected call of ListLiveHistory.
+func (mr *MockLiveHistoryRepositoryMockRecorder) ListLiveHistory(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLiveHistory", reflect.TypeOf((*MockLiveHistoryRepository)(nil).ListLiveHistory), ctx, from, to)
+}
+
+// ListLiveHistoryDays mocks base method.
+func (m *MockLiveHistoryRepository) ListLiveHistoryDays(ctx context.Context) ([]entities.LiveHistoryDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLiveHistoryDays", ctx)
+	ret0, _ := ret[0].([]entities.LiveHistoryDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLiveHistoryDays indicates an expected call of ListLiveHistoryDays.
+func (mr *MockLiveHistoryRepositoryMockRecorder) ListLiveHistoryDays(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLiveHistoryDays", reflect.TypeOf((*MockLiveHistoryRepository)(nil).ListLiveHistoryDays), ctx)
+}
+
+// StartLiveEntry mocks base method.
+func (m *MockLiveHistoryRepository) StartLiveEntry(ctx context.Context, entry entities.LiveHistoryEntry) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLiveEntry", ctx, entry)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLiveEntry indicates an expected call of StartLiveEntry.
+func (mr *MockLiveHistoryRepositoryMockRecorder) StartLiveEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveEntry", reflect.TypeOf((*MockLiveHistoryRepository)(nil).StartLiveEntry), ctx, entry)
+}
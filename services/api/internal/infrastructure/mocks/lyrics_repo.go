@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./lyrics_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entities "services/api/domain/entities"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLyricsRepository is a mock of LyricsRepository interface.
+type MockLyricsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLyricsRepositoryMockRecorder
+}
+
+// MockLyricsRepositoryMockRecorder is the mock recorder for MockLyricsRepository.
+type MockLyricsRepositoryMockRecorder struct {
+	mock *MockLyricsRepository
+}
+
+// NewMockLyricsRepository creates a new mock instance.
+func NewMockLyricsRepository(ctrl *gomock.Controller) *MockLyricsRepository {
+	mock := &MockLyricsRepository{ctrl: ctrl}
+	mock.recorder = &MockLyricsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLyricsRepository) EXPECT() *MockLyricsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteSong mocks base method.
+func (m *MockLyricsRepository) DeleteSong(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSong", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSong indicates an expected call of DeleteSong.
+func (mr *MockLyricsRepositoryMockRecorder) DeleteSong(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSong", reflect.TypeOf((*MockLyricsRepository)(nil).DeleteSong), ctx, id)
+}
+
+// GetSong mocks base method.
+func (m *MockLyricsRepository) GetSong(ctx context.Context, id string) (*entities.LyricsSong, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSong", ctx, id)
+	ret0, _ := ret[0].(*entities.LyricsSong)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSong indicates an expected call of GetSong.
+func (mr *MockLyricsRepositoryMockRecorder) GetSong(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSong", reflect.TypeOf((*MockLyricsRepository)(nil).GetSong), ctx, id)
+}
+
+// ListSongs mocks base method.
+func (m *MockLyricsRepository) ListSongs(ctx context.Context, filter entities.RequestLyricsSongs) ([]entities.LyricsSongSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSongs", ctx, filter)
+	ret0, _ := ret[0].([]entities.LyricsSongSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSongs indicates an expected call of ListSongs.
+func (mr *MockLyricsRepositoryMockRecorder) ListSongs(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSongs", reflect.TypeOf((*MockLyricsRepository)(nil).ListSongs), ctx, filter)
+}
+
+// ListTags mocks base method.
+func (m *MockLyricsRepository) ListTags(ctx context.Context) ([]entities.LyricsTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx)
+	ret0, _ := ret[0].([]entities.LyricsTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockLyricsRepositoryMockRecorder) ListTags(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockLyricsRepository)(nil).ListTags), ctx)
+}
+
+// UpsertSong mocks base method.
+func (m *MockLyricsRepository) UpsertSong(ctx context.Context, payload entities.LyricsSongPayload) (*entities.LyricsSong, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSong", ctx, payload)
+	ret0, _ := ret[0].(*entities.LyricsSong)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSong indicates an expected call of UpsertSong.
+func (mr *MockLyricsRepositoryMockRecorder) UpsertSong(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSong", reflect.TypeOf((*MockLyricsRepository)(nil).UpsertSong), ctx, payload)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./plan_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entities "services/api/domain/entities"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPlanRepository is a mock of PlanRepository interface.
+type MockPlanRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlanRepositoryMockRecorder
+}
+
+// MockPlanRepositoryMockRecorder is the mock recorder for MockPlanRepository.
+type MockPlanRepositoryMockRecorder struct {
+	mock *MockPlanRepository
+}
+
+// NewMockPlanRepository creates a new mock instance.
+func NewMockPlanRepository(ctrl *gomock.Controller) *MockPlanRepository {
+	mock := &MockPlanRepository{ctrl: ctrl}
+	mock.recorder = &MockPlanRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanRepository) EXPECT() *MockPlanRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePlan mocks base method.
+func (m *MockPlanRepository) DeletePlan(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlan", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlan indicates an expected call of DeletePlan.
+func (mr *MockPlanRepositoryMockRecorder) DeletePlan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlan", reflect.TypeOf((*MockPlanRepository)(nil).DeletePlan), ctx, id)
+}
+
+// GetPlan mocks base method.
+func (m *MockPlanRepository) GetPlan(ctx context.Context, id string) (*entities.ReadingPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlan", ctx, id)
+	ret0, _ := ret[0].(*entities.ReadingPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockPlanRepositoryMockRecorder) GetPlan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockPlanRepository)(nil).GetPlan), ctx, id)
+}
+
+// GetPlanDay mocks base method.
+func (m *MockPlanRepository) GetPlanDay(ctx context.Context, id string, day int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanDay", ctx, id, day)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlanDay indicates an expected call of GetPlanDay.
+func (mr *MockPlanRepositoryMockRecorder) GetPlanDay(ctx, id, day interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanDay", reflect.TypeOf((*MockPlanRepository)(nil).GetPlanDay), ctx, id, day)
+}
+
+// ImportPlan mocks base method.
+func (m *MockPlanRepository) ImportPlan(ctx context.Context, plan entities.ReadingPlanImport) (*entities.ReadingPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPlan", ctx, plan)
+	ret0, _ := ret[0].(*entities.ReadingPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPlan indicates an expected call of ImportPlan.
+func (mr *MockPlanRepositoryMockRecorder) ImportPlan(ctx, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPlan", reflect.TypeOf((*MockPlanRepository)(nil).ImportPlan), ctx, plan)
+}
+
+// ListPlans mocks base method.
+func (m *MockPlanRepository) ListPlans(ctx context.Context) ([]entities.ReadingPlanSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlans", ctx)
+	ret0, _ := ret[0].([]entities.ReadingPlanSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockPlanRepositoryMockRecorder) ListPlans(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockPlanRepository)(nil).ListPlans), ctx)
+}
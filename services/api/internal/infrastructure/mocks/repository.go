@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entities "services/api/domain/entities"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDatabaseGetter is a mock of DatabaseGetter interface.
+type MockDatabaseGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseGetterMockRecorder
+}
+
+// MockDatabaseGetterMockRecorder is the mock recorder for MockDatabaseGetter.
+type MockDatabaseGetterMockRecorder struct {
+	mock *MockDatabaseGetter
+}
+
+// NewMockDatabaseGetter creates a new mock instance.
+func NewMockDatabaseGetter(ctrl *gomock.Controller) *MockDatabaseGetter {
+	mock := &MockDatabaseGetter{ctrl: ctrl}
+	mock.recorder = &MockDatabaseGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseGetter) EXPECT() *MockDatabaseGetterMockRecorder {
+	return m.recorder
+}
+
+// GetBible mocks base method.
+func (m *MockDatabaseGetter) GetBible(ctx context.Context, version int) (*entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBible", ctx, version)
+	ret0, _ := ret[0].(*entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBible indicates an expected call of GetBible.
+func (mr *MockDatabaseGetterMockRecorder) GetBible(ctx, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBible", reflect.TypeOf((*MockDatabaseGetter)(nil).GetBible), ctx, version)
+}
+
+// GetBibleCatalogEntry mocks base method.
+func (m *MockDatabaseGetter) GetBibleCatalogEntry(ctx context.Context, version int) (*entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBibleCatalogEntry", ctx, version)
+	ret0, _ := ret[0].(*entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBibleCatalogEntry indicates an expected call of GetBibleCatalogEntry.
+func (mr *MockDatabaseGetterMockRecorder) GetBibleCatalogEntry(ctx, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBibleCatalogEntry", reflect.TypeOf((*MockDatabaseGetter)(nil).GetBibleCatalogEntry), ctx, version)
+}
+
+// GetBibleReferences mocks base method.
+func (m *MockDatabaseGetter) GetBibleReferences(ctx context.Context, request entities.RequestBible) (*entities.Chapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBibleReferences", ctx, request)
+	ret0, _ := ret[0].(*entities.Chapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBibleReferences indicates an expected call of GetBibleReferences.
+func (mr *MockDatabaseGetterMockRecorder) GetBibleReferences(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBibleReferences", reflect.TypeOf((*MockDatabaseGetter)(nil).GetBibleReferences), ctx, request)
+}
+
+// GetChapterSizes mocks base method.
+func (m *MockDatabaseGetter) GetChapterSizes(ctx context.Context, version int, book string) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChapterSizes", ctx, version, book)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChapterSizes indicates an expected call of GetChapterSizes.
+func (mr *MockDatabaseGetterMockRecorder) GetChapterSizes(ctx, version, book interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChapterSizes", reflect.TypeOf((*MockDatabaseGetter)(nil).GetChapterSizes), ctx, version, book)
+}
+
+// GetCrossReferences mocks base method.
+func (m *MockDatabaseGetter) GetCrossReferences(ctx context.Context, book string, chapter, verse, limit int) ([]entities.CrossReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrossReferences", ctx, book, chapter, verse, limit)
+	ret0, _ := ret[0].([]entities.CrossReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrossReferences indicates an expected call of GetCrossReferences.
+func (mr *MockDatabaseGetterMockRecorder) GetCrossReferences(ctx, book, chapter, verse, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossReferences", reflect.TypeOf((*MockDatabaseGetter)(nil).GetCrossReferences), ctx, book, chapter, verse, limit)
+}
+
+// GetPassage mocks base method.
+func (m *MockDatabaseGetter) GetPassage(ctx context.Context, version int, reference entities.ReferenceRange) ([]entities.PassageChapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassage", ctx, version, reference)
+	ret0, _ := ret[0].([]entities.PassageChapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassage indicates an expected call of GetPassage.
+func (mr *MockDatabaseGetterMockRecorder) GetPassage(ctx, version, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassage", reflect.TypeOf((*MockDatabaseGetter)(nil).GetPassage), ctx, version, reference)
+}
+
+// GetSearchCandidates mocks base method.
+func (m *MockDatabaseGetter) GetSearchCandidates(ctx context.Context, version int, word string, prefix bool, limit int) ([]entities.SearchCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCandidates", ctx, version, word, prefix, limit)
+	ret0, _ := ret[0].([]entities.SearchCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCandidates indicates an expected call of GetSearchCandidates.
+func (mr *MockDatabaseGetterMockRecorder) GetSearchCandidates(ctx, version, word, prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCandidates", reflect.TypeOf((*MockDatabaseGetter)(nil).GetSearchCandidates), ctx, version, word, prefix, limit)
+}
+
+// GetVersificationMappings mocks base method.
+func (m *MockDatabaseGetter) GetVersificationMappings(ctx context.Context, versification string) ([]entities.VersificationMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersificationMappings", ctx, versification)
+	ret0, _ := ret[0].([]entities.VersificationMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersificationMappings indicates an expected call of GetVersificationMappings.
+func (mr *MockDatabaseGetterMockRecorder) GetVersificationMappings(ctx, versification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersificationMappings", reflect.TypeOf((*MockDatabaseGetter)(nil).GetVersificationMappings), ctx, versification)
+}
+
+// ImportBible mocks base method.
+func (m *MockDatabaseGetter) ImportBible(ctx context.Context, bible entities.BibleImport) (*entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBible", ctx, bible)
+	ret0, _ := ret[0].(*entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBible indicates an expected call of ImportBible.
+func (mr *MockDatabaseGetterMockRecorder) ImportBible(ctx, bible interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBible", reflect.TypeOf((*MockDatabaseGetter)(nil).ImportBible), ctx, bible)
+}
+
+// ImportCrossReferences mocks base method.
+func (m *MockDatabaseGetter) ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCrossReferences", ctx, refs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCrossReferences indicates an expected call of ImportCrossReferences.
+func (mr *MockDatabaseGetterMockRecorder) ImportCrossReferences(ctx, refs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCrossReferences", reflect.TypeOf((*MockDatabaseGetter)(nil).ImportCrossReferences), ctx, refs)
+}
+
+// ListBibles mocks base method.
+func (m *MockDatabaseGetter) ListBibles(ctx context.Context) ([]entities.Bible, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBibles", ctx)
+	ret0, _ := ret[0].([]entities.Bible)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBibles indicates an expected call of ListBibles.
+func (mr *MockDatabaseGetterMockRecorder) ListBibles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBibles", reflect.TypeOf((*MockDatabaseGetter)(nil).ListBibles), ctx)
+}
+
+// ListBooks mocks base method.
+func (m *MockDatabaseGetter) ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBooks", ctx, version)
+	ret0, _ := ret[0].([]entities.BookInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBooks indicates an expected call of ListBooks.
+func (mr *MockDatabaseGetterMockRecorder) ListBooks(ctx, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBooks", reflect.TypeOf((*MockDatabaseGetter)(nil).ListBooks), ctx, version)
+}
+
+// SearchVerses mocks base method.
+func (m *MockDatabaseGetter) SearchVerses(ctx context.Context, query entities.SearchQuery, version, limit, offset int) (*entities.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchVerses", ctx, query, version, limit, offset)
+	ret0, _ := ret[0].(*entities.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchVerses indicates an expected call of SearchVerses.
+func (mr *MockDatabaseGetterMockRecorder) SearchVerses(ctx, query, version, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVerses", reflect.TypeOf((*MockDatabaseGetter)(nil).SearchVerses), ctx, query, version, limit, offset)
+}
+
+// UpdateBible mocks base method.
+func (m *MockDatabaseGetter) UpdateBible(ctx context.Context, bible entities.Bible) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBible", ctx, bible)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBible indicates an expected call of UpdateBible.
+func (mr *MockDatabaseGetterMockRecorder) UpdateBible(ctx, bible interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBible", reflect.TypeOf((*MockDatabaseGetter)(nil).UpdateBible), ctx, bible)
+}
+
+// ValidateBibleImport mocks base method.
+func (m *MockDatabaseGetter) ValidateBibleImport(ctx context.Context, bible entities.BibleImport) (*entities.ImportValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBibleImport", ctx, bible)
+	ret0, _ := ret[0].(*entities.ImportValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateBibleImport indicates an expected call of ValidateBibleImport.
+func (mr *MockDatabaseGetterMockRecorder) ValidateBibleImport(ctx, bible interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBibleImport", reflect.TypeOf((*MockDatabaseGetter)(nil).ValidateBibleImport), ctx, bible)
+}
+
+// VerifyBibleReference mocks base method.
+func (m *MockDatabaseGetter) VerifyBibleReference(ctx context.Context, request entities.RequestBible) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyBibleReference", ctx, request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBibleReference indicates an expected call of VerifyBibleReference.
+func (mr *MockDatabaseGetterMockRecorder) VerifyBibleReference(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBibleReference", reflect.TypeOf((*MockDatabaseGetter)(nil).VerifyBibleReference), ctx, request)
+}
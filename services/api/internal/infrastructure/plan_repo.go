@@ -0,0 +1,165 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"services/api/domain/entities"
+	"time"
+)
+
+//go:generate mockgen -source=./plan_repo.go -destination=./mocks/plan_repo.go -package=mocks
+
+type PlanRepository interface {
+	ListPlans(ctx context.Context) ([]entities.ReadingPlanSummary, error)
+	GetPlan(ctx context.Context, id string) (*entities.ReadingPlan, error)
+	GetPlanDay(ctx context.Context, id string, day int) ([]string, error)
+	ImportPlan(ctx context.Context, plan entities.ReadingPlanImport) (*entities.ReadingPlan, error)
+	DeletePlan(ctx context.Context, id string) error
+}
+
+type PlanRepo struct {
+	db *sql.DB
+}
+
+func NewPlanRepo(db *sql.DB) PlanRepository {
+	return &PlanRepo{db: db}
+}
+
+func (r *PlanRepo) ListPlans(ctx context.Context) ([]entities.ReadingPlanSummary, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name, start_date, days, updated_at FROM reading_plans ORDER BY updated_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []entities.ReadingPlanSummary{}
+	for rows.Next() {
+		var item entities.ReadingPlanSummary
+		if err := rows.Scan(&item.ID, &item.Name, &item.StartDate, &item.Days, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetPlan returns a plan with its whole schedule; sql.ErrNoRows when it does
+// not exist.
+func (r *PlanRepo) GetPlan(ctx context.Context, id string) (*entities.ReadingPlan, error) {
+	var plan entities.ReadingPlan
+	err := r.db.QueryRowContext(ctx, `SELECT id, name, description, start_date, days, created_at, updated_at FROM reading_plans WHERE id = ?`, id).
+		Scan(&plan.ID, &plan.Name, &plan.Description, &plan.StartDate, &plan.Days, &plan.CreatedAt, &plan.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT day, reference FROM reading_plan_days WHERE plan_id = ? ORDER BY day, position`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plan.Schedule = []entities.ReadingPlanDay{}
+	for rows.Next() {
+		var (
+			day       int
+			reference string
+		)
+		if err := rows.Scan(&day, &reference); err != nil {
+			return nil, err
+		}
+		if last := len(plan.Schedule) - 1; last < 0 || plan.Schedule[last].Day != day {
+			plan.Schedule = append(plan.Schedule, entities.ReadingPlanDay{Day: day})
+		}
+		last := &plan.Schedule[len(plan.Schedule)-1]
+		last.Readings = append(last.Readings, reference)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// GetPlanDay returns the readings of one day in order, empty on a rest day.
+func (r *PlanRepo) GetPlanDay(ctx context.Context, id string, day int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT reference FROM reading_plan_days WHERE plan_id = ? AND day = ? ORDER BY position`, id, day)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	readings := []string{}
+	for rows.Next() {
+		var reference string
+		if err := rows.Scan(&reference); err != nil {
+			return nil, err
+		}
+		readings = append(readings, reference)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return readings, nil
+}
+
+// ImportPlan stores a plan, replacing the schedule of an existing plan with
+// the same id.
+func (r *PlanRepo) ImportPlan(ctx context.Context, plan entities.ReadingPlanImport) (*entities.ReadingPlan, error) {
+	days := 0
+	for _, day := range plan.Schedule {
+		days = max(days, day.Day)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	_, err = txn.ExecContext(
+		ctx,
+		`INSERT INTO reading_plans (id, name, description, start_date, days, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?)
+         ON CONFLICT(id) DO UPDATE SET name = excluded.name, description = excluded.description, start_date = excluded.start_date, days = excluded.days, updated_at = excluded.updated_at`,
+		plan.ID,
+		plan.Name,
+		plan.Description,
+		plan.StartDate,
+		days,
+		now,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := txn.ExecContext(ctx, `DELETE FROM reading_plan_days WHERE plan_id = ?`, plan.ID); err != nil {
+		return nil, err
+	}
+	stmt, err := txn.PrepareContext(ctx, `INSERT INTO reading_plan_days (plan_id, day, position, reference) VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	for _, day := range plan.Schedule {
+		for position, reference := range day.Readings {
+			if _, err := stmt.ExecContext(ctx, plan.ID, day.Day, position, reference); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return r.GetPlan(ctx, plan.ID)
+}
+
+func (r *PlanRepo) DeletePlan(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM reading_plans WHERE id = ?`, id)
+	return err
+}
@@ -7,13 +7,27 @@ import (
 	"fmt"
 	"services/api/domain/consts"
 	"services/api/domain/entities"
-	"strings"
 )
 
+//go:generate mockgen -source=./repository.go -destination=./mocks/repository.go -package=mocks
+
 type DatabaseGetter interface {
+	ListBibles(ctx context.Context) ([]entities.Bible, error)
+	GetBible(ctx context.Context, version int) (*entities.Bible, error)
+	GetBibleCatalogEntry(ctx context.Context, version int) (*entities.Bible, error)
+	UpdateBible(ctx context.Context, bible entities.Bible) error
+	GetVersificationMappings(ctx context.Context, versification string) ([]entities.VersificationMapping, error)
+	ListBooks(ctx context.Context, version int) ([]entities.BookInfo, error)
 	VerifyBibleReference(ctx context.Context, request entities.RequestBible) (bool, error)
 	GetBibleReferences(ctx context.Context, request entities.RequestBible) (*entities.Chapter, error)
-	SearchVerses(ctx context.Context, terms []string, version int, limit int) ([]entities.VerseMatch, error)
+	GetChapterSizes(ctx context.Context, version int, book string) (map[int]int, error)
+	GetPassage(ctx context.Context, version int, reference entities.ReferenceRange) ([]entities.PassageChapter, error)
+	SearchVerses(ctx context.Context, query entities.SearchQuery, version int, limit int, offset int) (*entities.SearchResult, error)
+	GetSearchCandidates(ctx context.Context, version int, word string, prefix bool, limit int) ([]entities.SearchCandidate, error)
+	GetCrossReferences(ctx context.Context, book string, chapter int, verse int, limit int) ([]entities.CrossReference, error)
+	ImportCrossReferences(ctx context.Context, refs []entities.CrossReference) (int, error)
+	ValidateBibleImport(ctx context.Context, bible entities.BibleImport) (*entities.ImportValidation, error)
+	ImportBible(ctx context.Context, bible entities.BibleImport) (*entities.Bible, error)
 }
 
 type Database struct {
@@ -26,6 +40,93 @@ func NewBibleRepo(db *sql.DB) DatabaseGetter {
 	}
 }
 
+func (a *Database) ListBibles(ctx context.Context) ([]entities.Bible, error) {
+	rows, err := a.db.QueryContext(ctx, listBiblesQuery+` ORDER BY bl.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bibles := []entities.Bible{}
+	for rows.Next() {
+		var item entities.Bible
+		if err := rows.Scan(&item.ID, &item.Name, &item.Abbreviation, &item.Language, &item.License, &item.Attribution, &item.Versification, &item.Books, &item.Verses); err != nil {
+			return nil, err
+		}
+		bibles = append(bibles, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bibles, nil
+}
+
+// GetBible returns one installed version without its book and verse
+// counts, for the reads that only check it exists and attribute it;
+// sql.ErrNoRows when it does not exist.
+func (a *Database) GetBible(ctx context.Context, version int) (*entities.Bible, error) {
+	var item entities.Bible
+	err := a.db.QueryRowContext(ctx, getBibleQuery, bibleVersion(version)).
+		Scan(&item.ID, &item.Name, &item.Abbreviation, &item.Language, &item.License, &item.Attribution, &item.Versification)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetBibleCatalogEntry returns one installed version as ListBibles does,
+// with its book and verse counts; sql.ErrNoRows when it does not exist.
+func (a *Database) GetBibleCatalogEntry(ctx context.Context, version int) (*entities.Bible, error) {
+	var item entities.Bible
+	err := a.db.QueryRowContext(ctx, bibleCatalogEntryQuery, bibleVersion(version)).
+		Scan(&item.ID, &item.Name, &item.Abbreviation, &item.Language, &item.License, &item.Attribution, &item.Versification, &item.Books, &item.Verses)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateBible replaces the catalog details of an installed version;
+// sql.ErrNoRows when it does not exist.
+func (a *Database) UpdateBible(ctx context.Context, bible entities.Bible) error {
+	result, err := a.db.ExecContext(ctx, updateBibleQuery, bible.Name, bible.Abbreviation, bible.Language,
+		bible.License, bible.Attribution, bible.ID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetVersificationMappings returns the rows that convert a numbering scheme
+// to the standard one. The standard scheme has none.
+func (a *Database) GetVersificationMappings(ctx context.Context, versification string) ([]entities.VersificationMapping, error) {
+	rows, err := a.db.QueryContext(ctx, versificationMappingsQuery, versification)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mappings := []entities.VersificationMapping{}
+	for rows.Next() {
+		item := entities.VersificationMapping{Versification: versification}
+		if err := rows.Scan(&item.Book, &item.FirstChapter, &item.LastChapter, &item.FirstVerse, &item.LastVerse, &item.ChapterOffset, &item.VerseOffset); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
 func (a *Database) VerifyBibleReference(ctx context.Context, request entities.RequestBible) (bool, error) {
 	if _, exist := consts.Books[request.Book]; !exist {
 		return false, errors.New("notFound")
@@ -41,6 +142,7 @@ func (a *Database) VerifyBibleReference(ctx context.Context, request entities.Re
 	err = txn.QueryRowContext(
 		ctx,
 		verifyBibleReferenceQuery,
+		bibleVersion(request.Version),
 		request.Book,
 		request.Chapter).Scan(&numberVerses)
 	if err != nil {
@@ -66,9 +168,9 @@ func (a *Database) GetBibleReferences(ctx context.Context, request entities.Requ
 	rows, err := txn.QueryContext(
 		ctx,
 		query,
+		bibleVersion(request.Version),
 		request.Book,
-		request.Chapter,
-		request.Version)
+		request.Chapter)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +180,7 @@ func (a *Database) GetBibleReferences(ctx context.Context, request entities.Requ
 	verse := entities.Verse{}
 
 	for rows.Next() {
-		err = rows.Scan(&chapterFound.Name, &chapterFound.Research, &verse.Research, &verse.Index, &verse.Text)
+		err = rows.Scan(&chapterFound.Name, &chapterFound.Research, &verse.Research, &verse.Index, &verse.Last, &verse.Text)
 		if err != nil {
 			return nil, err
 		}
@@ -93,51 +195,108 @@ func (a *Database) GetBibleReferences(ctx context.Context, request entities.Requ
 	return &chapterFound, nil
 }
 
-func (a *Database) SearchVerses(ctx context.Context, terms []string, version int, limit int) ([]entities.VerseMatch, error) {
-	if len(terms) == 0 {
-		return nil, nil
-	}
-	if version <= 0 {
-		version = 1
+// GetChapterSizes returns the number of verses of each chapter of a book in
+// one version, keyed by chapter. It is empty when the version lacks the book.
+func (a *Database) GetChapterSizes(ctx context.Context, version int, book string) (map[int]int, error) {
+	rows, err := a.db.QueryContext(ctx, chapterSizesQuery, bibleVersion(version), book)
+	if err != nil {
+		return nil, err
 	}
-	if limit <= 0 {
-		limit = 8
+	defer rows.Close()
+
+	sizes := map[int]int{}
+	for rows.Next() {
+		var chapter, numberVerses int
+		if err := rows.Scan(&chapter, &numberVerses); err != nil {
+			return nil, err
+		}
+		sizes[chapter] = numberVerses
 	}
-	if limit > 20 {
-		limit = 20
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
+	return sizes, nil
+}
 
-	query, args := buildSearchVersesQuery(terms, version, limit)
-
-	rows, err := a.db.QueryContext(ctx, query, args...)
+// GetPassage returns the verses of one range grouped by chapter, in order.
+func (a *Database) GetPassage(ctx context.Context, version int, reference entities.ReferenceRange) ([]entities.PassageChapter, error) {
+	rows, err := a.db.QueryContext(
+		ctx,
+		passageQuery,
+		bibleVersion(version),
+		reference.Book,
+		reference.StartChapter,
+		reference.EndChapter,
+		reference.StartChapter,
+		reference.StartVerse,
+		reference.EndChapter,
+		reference.EndVerse)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	results := make([]entities.VerseMatch, 0, limit)
+	chapters := []entities.PassageChapter{}
 	for rows.Next() {
-		var item entities.VerseMatch
-		if err := rows.Scan(&item.Book, &item.Chapter, &item.Verse, &item.Text); err != nil {
+		var (
+			index    int
+			name     string
+			research string
+			verse    entities.Verse
+		)
+		if err := rows.Scan(&index, &name, &research, &verse.Research, &verse.Index, &verse.Last, &verse.Text); err != nil {
 			return nil, err
 		}
-		results = append(results, item)
+		if last := len(chapters) - 1; last < 0 || chapters[last].Chapter != index {
+			chapter := entities.PassageChapter{Book: reference.Book, Chapter: index, Name: name}
+			if verse.Index == 1 {
+				chapter.Research = research
+			}
+			chapters = append(chapters, chapter)
+		}
+		last := &chapters[len(chapters)-1]
+		last.Verses = append(last.Verses, verse)
 	}
-
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-
-	return results, nil
+	return chapters, nil
 }
 
+// defaultBibleVersion is the bundled Reina Valera 1960, used when a request
+// does not name a version.
+const defaultBibleVersion = 1
+
 const (
-	verifyBibleReferenceQuery = `SELECT number_verses FROM chapters_verses WHERE book = ? AND chapter = ?`
-	biblicalReferencesQuery   = `SELECT c.title, c.research, v.research, v."index", v.content 
-									FROM books b INNER JOIN chapters c ON b.name = c.book INNER JOIN verses v ON c.id = v.chapter INNER JOIN bibles bl ON  bl.id = b.bible_id
-        					   		WHERE b.name = ? AND c."index" = ?  AND bl.id = ? ORDER BY v."index"`
+	listBiblesQuery = `SELECT bl.id, bl.version_name, bl.abbreviation, bl.language, bl.license, bl.attribution, bl.versification,
+							(SELECT COUNT(*) FROM books b WHERE b.bible_id = bl.id),
+							(SELECT COALESCE(SUM(MAX(v."last", v."index") - v."index" + 1), 0) FROM verses v INNER JOIN chapters c ON c.id = v.chapter INNER JOIN books b ON b.id = c.book_id WHERE b.bible_id = bl.id)
+					   FROM bibles bl`
+	bibleCatalogEntryQuery     = listBiblesQuery + ` WHERE bl.id = ?`
+	getBibleQuery              = `SELECT id, version_name, abbreviation, language, license, attribution, versification FROM bibles WHERE id = ?`
+	updateBibleQuery           = `UPDATE bibles SET version_name = ?, abbreviation = ?, language = ?, license = ?, attribution = ? WHERE id = ?`
+	versificationMappingsQuery = `SELECT book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset
+									FROM versification_mappings WHERE versification = ? ORDER BY book, first_chapter, first_verse`
+	verifyBibleReferenceQuery = `SELECT number_verses FROM chapters_verses WHERE bible_id = ? AND book = ? AND chapter = ?`
+	chapterSizesQuery         = `SELECT chapter, number_verses FROM chapters_verses WHERE bible_id = ? AND book = ?`
+	biblicalReferencesQuery   = `SELECT c.title, c.research, v.research, v."index", v."last", v.content
+									FROM books b INNER JOIN chapters c ON b.id = c.book_id INNER JOIN verses v ON c.id = v.chapter
+        					   		WHERE b.bible_id = ? AND b.name = ? AND c."index" = ? ORDER BY v."index"`
+	passageQuery = `SELECT c."index", c.title, c.research, v.research, v."index", v."last", v.content
+					FROM books b INNER JOIN chapters c ON b.id = c.book_id INNER JOIN verses v ON c.id = v.chapter
+					WHERE b.bible_id = ? AND b.name = ? AND c."index" BETWEEN ? AND ?
+						AND (c."index" > ? OR MAX(v."last", v."index") >= ?)
+						AND (c."index" < ? OR v."index" <= ?)
+					ORDER BY c."index", v."index"`
 )
 
+func bibleVersion(version int) int {
+	if version <= 0 {
+		return defaultBibleVersion
+	}
+	return version
+}
+
 func applyPagination(query string, offset int, limit int) string {
 	// SQLite requires LIMIT before OFFSET; OFFSET alone needs LIMIT -1.
 	if limit != 0 {
@@ -152,81 +311,3 @@ func applyPagination(query string, offset int, limit int) string {
 	}
 	return query
 }
-
-var searchTermReplacer = strings.NewReplacer(
-	"\u00e1", "a",
-	"\u00e9", "e",
-	"\u00ed", "i",
-	"\u00f3", "o",
-	"\u00fa", "u",
-	"\u00fc", "u",
-	"\u00f1", "n",
-)
-
-var searchAccentReplacements = []struct {
-	from string
-	to   string
-}{
-	{from: "\u00c1", to: "a"},
-	{from: "\u00c9", to: "e"},
-	{from: "\u00cd", to: "i"},
-	{from: "\u00d3", to: "o"},
-	{from: "\u00da", to: "u"},
-	{from: "\u00dc", to: "u"},
-	{from: "\u00d1", to: "n"},
-	{from: "\u00e1", to: "a"},
-	{from: "\u00e9", to: "e"},
-	{from: "\u00ed", to: "i"},
-	{from: "\u00f3", to: "o"},
-	{from: "\u00fa", to: "u"},
-	{from: "\u00fc", to: "u"},
-	{from: "\u00f1", to: "n"},
-}
-
-func normalizeSearchTerm(term string) string {
-	normalized := strings.ToLower(strings.TrimSpace(term))
-	if normalized == "" {
-		return ""
-	}
-	return searchTermReplacer.Replace(normalized)
-}
-
-func normalizedSQLiteExpr(expr string) string {
-	normalized := expr
-	for _, replacement := range searchAccentReplacements {
-		normalized = fmt.Sprintf("replace(%s, '%s', '%s')", normalized, replacement.from, replacement.to)
-	}
-	return fmt.Sprintf("lower(%s)", normalized)
-}
-
-func buildSearchVersesQuery(terms []string, version int, limit int) (string, []interface{}) {
-	query := `SELECT b.name, c."index", v."index", v.content
-			  FROM verses v
-			  INNER JOIN chapters c ON c.id = v.chapter
-			  INNER JOIN books b ON b.name = c.book
-			  INNER JOIN bibles bl ON bl.id = b.bible_id
-			  WHERE bl.id = ?`
-
-	args := make([]interface{}, 0, len(terms)+2)
-	args = append(args, version)
-
-	normalizedContent := normalizedSQLiteExpr("v.content")
-	termCount := 0
-	for _, term := range terms {
-		normalizedTerm := normalizeSearchTerm(term)
-		if normalizedTerm == "" {
-			continue
-		}
-		termCount++
-		query += fmt.Sprintf(" AND %s LIKE ?", normalizedContent)
-		args = append(args, "%"+normalizedTerm+"%")
-	}
-	if termCount == 0 {
-		query += " AND 1 = 0"
-	}
-
-	query += ` ORDER BY b.name, c."index", v."index" LIMIT ?`
-	args = append(args, limit)
-
-	return query, args
-}
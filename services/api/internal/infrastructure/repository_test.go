@@ -0,0 +1,51 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"testing"
+)
+
+func TestDatabase_UpdateBible(t *testing.T) {
+	t.Run("should store the details and read them back with and without counts", func(t *testing.T) {
+		repo := infrastructure.NewBibleRepo(setupTestDB(t))
+		ctx := context.Background()
+		imported, err := repo.ImportBible(ctx, entities.BibleImport{
+			Name: "Traducción de prueba",
+			Books: []entities.ImportBook{{Name: "juan", Title: "Juan", Chapters: []entities.ImportChapter{{
+				Index:  3,
+				Verses: []entities.ImportVerse{{Index: 16, Text: "Porque de tal manera amó Dios al mundo."}},
+			}}}},
+		})
+		require.NoError(t, err)
+
+		err = repo.UpdateBible(ctx, entities.Bible{ID: imported.ID, Name: "Traducción de prueba", Abbreviation: "TP", License: "© Prueba", Attribution: "TP"})
+		require.NoError(t, err)
+
+		bible, err := repo.GetBible(ctx, imported.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "TP", bible.Abbreviation)
+		assert.Equal(t, "© Prueba", bible.License)
+		assert.Zero(t, bible.Verses)
+
+		entry, err := repo.GetBibleCatalogEntry(ctx, imported.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "TP", entry.Attribution)
+		assert.Equal(t, 1, entry.Books)
+		assert.Equal(t, 1, entry.Verses)
+	})
+
+	t.Run("should report a bible that is not installed", func(t *testing.T) {
+		repo := infrastructure.NewBibleRepo(setupTestDB(t))
+
+		err := repo.UpdateBible(context.Background(), entities.Bible{ID: 99, Name: "NVI"})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = repo.GetBible(context.Background(), 99)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
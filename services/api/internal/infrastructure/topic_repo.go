@@ -0,0 +1,158 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"strings"
+)
+
+type TopicRepository interface {
+	ImportTopics(ctx context.Context, topics []entities.TopicImport) (int, error)
+	SearchTopics(ctx context.Context, query string, limit int) ([]entities.Topic, error)
+	GetTopic(ctx context.Context, id int64) (*entities.Topic, error)
+	GetTopicReferences(ctx context.Context, id int64, limit int, offset int) ([]entities.ReferenceRange, error)
+}
+
+type TopicRepo struct {
+	db *sql.DB
+}
+
+func NewTopicRepo(db *sql.DB) TopicRepository {
+	return &TopicRepo{db: db}
+}
+
+// ImportTopics replaces the topical index with topics in one transaction
+// and returns how many topics were stored.
+func (r *TopicRepo) ImportTopics(ctx context.Context, topics []entities.TopicImport) (int, error) {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer txn.Rollback()
+
+	if _, err := txn.ExecContext(ctx, `DELETE FROM topics`); err != nil {
+		return 0, fmt.Errorf("clear topics: %w", err)
+	}
+	stmt, err := txn.PrepareContext(ctx, insertTopicReferenceQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	for _, topic := range topics {
+		result, err := txn.ExecContext(ctx, `INSERT INTO topics (name, normalized) VALUES (?, ?)`, topic.Name, normalizeSearchTerm(topic.Name))
+		if err != nil {
+			return 0, fmt.Errorf("insert topic %q: %w", topic.Name, err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		for position, ref := range topic.References {
+			if _, err := stmt.ExecContext(ctx, id, position, ref.Book, ref.StartChapter, ref.StartVerse, ref.EndChapter, ref.EndVerse); err != nil {
+				return 0, fmt.Errorf("insert reference %s of %q: %w", ref.Reference, topic.Name, err)
+			}
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, err
+	}
+	return len(topics), nil
+}
+
+// SearchTopics finds the topics whose name contains every word of query,
+// ignoring case and accents, so "perdon" matches "Perdón". Exact names come
+// first, then names that start with the query, then the topics with more
+// references.
+func (r *TopicRepo) SearchTopics(ctx context.Context, query string, limit int) ([]entities.Topic, error) {
+	normalized := normalizeSearchTerm(query)
+	words := strings.Fields(normalized)
+	if len(words) == 0 {
+		return []entities.Topic{}, nil
+	}
+
+	conditions := make([]string, 0, len(words))
+	args := make([]interface{}, 0, len(words)+3)
+	for _, word := range words {
+		conditions = append(conditions, `t.normalized LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(word)+"%")
+	}
+	args = append(args, normalized, escapeLike(normalized)+"%", limit)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(searchTopicsQuery, strings.Join(conditions, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	topics := []entities.Topic{}
+	for rows.Next() {
+		var topic entities.Topic
+		if err := rows.Scan(&topic.ID, &topic.Name, &topic.References); err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+// GetTopic returns one topic; sql.ErrNoRows when it does not exist.
+func (r *TopicRepo) GetTopic(ctx context.Context, id int64) (*entities.Topic, error) {
+	var topic entities.Topic
+	err := r.db.QueryRowContext(ctx, topicQuery+` WHERE t.id = ?`, id).Scan(&topic.ID, &topic.Name, &topic.References)
+	if err != nil {
+		return nil, err
+	}
+	return &topic, nil
+}
+
+// GetTopicReferences returns one page of the references of a topic in the
+// standard numbering, in file order.
+func (r *TopicRepo) GetTopicReferences(ctx context.Context, id int64, limit int, offset int) ([]entities.ReferenceRange, error) {
+	rows, err := r.db.QueryContext(ctx, applyPagination(topicReferencesQuery, offset, limit), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refs := []entities.ReferenceRange{}
+	for rows.Next() {
+		var ref entities.ReferenceRange
+		if err := rows.Scan(&ref.Book, &ref.StartChapter, &ref.StartVerse, &ref.EndChapter, &ref.EndVerse); err != nil {
+			return nil, err
+		}
+		ref.BookName = consts.Books[ref.Book]
+		ref.Reference = bibleref.Format(ref)
+		refs = append(refs, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
+const (
+	topicQuery = `SELECT t.id, t.name, (SELECT COUNT(*) FROM topic_references tr WHERE tr.topic_id = t.id)
+				  FROM topics t`
+	searchTopicsQuery = topicQuery + ` WHERE %s
+						ORDER BY t.normalized = ? DESC, t.normalized LIKE ? ESCAPE '\' DESC, 3 DESC, t.name
+						LIMIT ?`
+	topicReferencesQuery = `SELECT book, start_chapter, start_verse, end_chapter, end_verse
+							FROM topic_references WHERE topic_id = ? ORDER BY position`
+	insertTopicReferenceQuery = `INSERT INTO topic_references (topic_id, position, book, start_chapter, start_verse, end_chapter, end_verse)
+								 VALUES (?, ?, ?, ?, ?, ?, ?)`
+)
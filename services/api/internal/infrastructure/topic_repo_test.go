@@ -0,0 +1,84 @@
+package infrastructure_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/infrastructure"
+	"testing"
+)
+
+func TestTopicRepo_SearchTopics(t *testing.T) {
+	t.Run("should match names ignoring case and accents", func(t *testing.T) {
+		repo := setupTopicRepo(t)
+
+		found, err := repo.SearchTopics(context.Background(), "PERDON", 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Perdón", "Perdón de pecados"}, foundTopicNames(found))
+	})
+
+	t.Run("should put the exact name first, then names that start with the query", func(t *testing.T) {
+		repo := setupTopicRepo(t)
+
+		found, err := repo.SearchTopics(context.Background(), "paz", 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Paz", "Paz, espiritual", "Príncipe de paz"}, foundTopicNames(found))
+		assert.Equal(t, 2, found[0].References)
+	})
+
+	t.Run("should require every word", func(t *testing.T) {
+		repo := setupTopicRepo(t)
+
+		found, err := repo.SearchTopics(context.Background(), "pecados perdon", 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Perdón de pecados"}, foundTopicNames(found))
+	})
+}
+
+func TestTopicRepo_GetTopicReferences(t *testing.T) {
+	t.Run("should return the references in file order", func(t *testing.T) {
+		repo := setupTopicRepo(t)
+		ctx := context.Background()
+		found, err := repo.SearchTopics(ctx, "paz", 1)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+
+		refs, err := repo.GetTopicReferences(ctx, found[0].ID, 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, refs, 2)
+		assert.Equal(t, "Juan 14:27", refs[0].Reference)
+		assert.Equal(t, "Filipenses 4:7", refs[1].Reference)
+	})
+}
+
+// setupTopicRepo imports a small topical index into a fresh database.
+func setupTopicRepo(t *testing.T) infrastructure.TopicRepository {
+	t.Helper()
+	repo := infrastructure.NewTopicRepo(setupTestDB(t))
+	_, err := repo.ImportTopics(context.Background(), []entities.TopicImport{
+		{Name: "Príncipe de paz", References: []entities.ReferenceRange{verseRange("isaias", 9, 6)}},
+		{Name: "Paz, espiritual", References: []entities.ReferenceRange{verseRange("romanos", 5, 1)}},
+		{Name: "Paz", References: []entities.ReferenceRange{verseRange("juan", 14, 27), verseRange("filipenses", 4, 7)}},
+		{Name: "Perdón de pecados", References: []entities.ReferenceRange{verseRange("1-juan", 1, 9)}},
+		{Name: "Perdón", References: []entities.ReferenceRange{verseRange("mateo", 6, 14), verseRange("efesios", 4, 32)}},
+	})
+	require.NoError(t, err)
+	return repo
+}
+
+func verseRange(book string, chapter int, verse int) entities.ReferenceRange {
+	return entities.ReferenceRange{Book: book, StartChapter: chapter, StartVerse: verse, EndChapter: chapter, EndVerse: verse}
+}
+
+func foundTopicNames(found []entities.Topic) []string {
+	names := make([]string, 0, len(found))
+	for _, topic := range found {
+		names = append(names, topic.Name)
+	}
+	return names
+}
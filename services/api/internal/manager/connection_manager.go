@@ -126,6 +126,22 @@ func SetLastLiveStatus(message []byte) {
 	hub.mu.Unlock()
 }
 
+// LastLiveStatus returns the last liveStatus message relayed, nil before the
+// first one.
+func LastLiveStatus() []byte {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	return hub.lastLive
+}
+
+// LastScene returns the last sceneUpdate message relayed, nil before the
+// first one.
+func LastScene() []byte {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	return hub.lastScene
+}
+
 func StartWriter(client *Client) {
 	go func() {
 		ticker := time.NewTicker(pingPeriod)
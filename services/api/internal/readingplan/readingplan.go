@@ -0,0 +1,225 @@
+// Package readingplan reads reading plans: a schedule that assigns passages
+// to numbered days, like a one-year Bible plan.
+package readingplan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Metadata overrides what the file says about the plan; empty fields keep
+// the file's value.
+type Metadata struct {
+	Name        string
+	Description string
+	StartDate   string
+}
+
+// DetectFormat picks the format from the file extension, JSON by default.
+func DetectFormat(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".tsv", ".txt":
+		return FormatCSV
+	}
+	return FormatJSON
+}
+
+// Parse reads a plan in the given format. Every reading is checked with
+// bibleref.Parse and stored in its canonical form.
+func Parse(format string, r io.Reader, meta Metadata) (*entities.ReadingPlanImport, error) {
+	var (
+		plan *entities.ReadingPlanImport
+		err  error
+	)
+	switch format {
+	case FormatJSON:
+		plan, err = ParseJSON(r)
+	case FormatCSV:
+		plan, err = ParseCSV(r)
+	default:
+		return nil, fmt.Errorf("unknown plan format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.Name != "" {
+		plan.Name = meta.Name
+	}
+	if meta.Description != "" {
+		plan.Description = meta.Description
+	}
+	if meta.StartDate != "" {
+		plan.StartDate = meta.StartDate
+	}
+	if plan.Name == "" {
+		return nil, errors.New("plan name is required")
+	}
+	if plan.StartDate != "" {
+		if _, err := time.Parse("2006-01-02", plan.StartDate); err != nil {
+			return nil, fmt.Errorf("startDate must be YYYY-MM-DD, got %q", plan.StartDate)
+		}
+	}
+	if len(plan.Schedule) == 0 {
+		return nil, errors.New("plan has no readings")
+	}
+	return plan, nil
+}
+
+type jsonPlan struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	StartDate   string    `json:"startDate"`
+	Days        []jsonDay `json:"days"`
+}
+
+type jsonDay struct {
+	Day      int             `json:"day"`
+	Readings json.RawMessage `json:"readings"`
+}
+
+// ParseJSON reads {"name": ..., "startDate": ..., "days": [{"day": 1,
+// "readings": ["Génesis 1-2", "Mateo 1"]}]} or the bare list of days.
+// Readings may also be one string such as "Génesis 1-2; Mateo 1", and a day
+// without a number follows the previous one.
+func ParseJSON(r io.Reader) (*entities.ReadingPlanImport, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc jsonPlan
+	if trimmed := bytes.TrimSpace(content); bytes.HasPrefix(trimmed, []byte("[")) {
+		err = json.Unmarshal(trimmed, &doc.Days)
+	} else {
+		err = json.Unmarshal(trimmed, &doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	s := schedule{}
+	previous := 0
+	for i, day := range doc.Days {
+		number := day.Day
+		if number == 0 {
+			number = previous + 1
+		}
+		previous = number
+
+		var readings []string
+		if err := json.Unmarshal(day.Readings, &readings); err != nil {
+			var single string
+			if err := json.Unmarshal(day.Readings, &single); err != nil {
+				return nil, fmt.Errorf("day %d: readings must be a string or a list of strings", i+1)
+			}
+			readings = []string{single}
+		}
+		for _, reading := range readings {
+			if err := s.add(number, reading); err != nil {
+				return nil, fmt.Errorf("day %d: %w", number, err)
+			}
+		}
+	}
+	return &entities.ReadingPlanImport{
+		Name:        doc.Name,
+		Description: doc.Description,
+		StartDate:   doc.StartDate,
+		Schedule:    s.days(),
+	}, nil
+}
+
+// ParseCSV reads "day,reading" rows; extra columns are more readings of the
+// same day and a day may span several rows. A header row such as
+// "dia,lectura" is optional. Comma and tab separators are detected from
+// the first line; semicolons stay inside a reading, as in
+// "Génesis 1; Mateo 1".
+func ParseCSV(r io.Reader) (*entities.ReadingPlanImport, error) {
+	buffered := bufio.NewReader(r)
+	head, _ := buffered.Peek(1024)
+	if bytes.HasPrefix(head, []byte("\xef\xbb\xbf")) {
+		_, _ = buffered.Discard(3)
+		head = head[3:]
+	}
+
+	reader := csv.NewReader(buffered)
+	if firstLine, _, _ := bytes.Cut(head, []byte("\n")); bytes.Count(firstLine, []byte("\t")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = '\t'
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	s := schedule{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		line++
+		if len(record) < 2 && strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		day, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid day %q", line, record[0])
+		}
+		for _, reading := range record[1:] {
+			if strings.TrimSpace(reading) == "" {
+				continue
+			}
+			if err := s.add(day, reading); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+	}
+	return &entities.ReadingPlanImport{Schedule: s.days()}, nil
+}
+
+// schedule collects readings by day, keeping the order they were read in.
+type schedule map[int][]string
+
+func (s schedule) add(day int, reading string) error {
+	if day < 1 {
+		return fmt.Errorf("day must be 1 or more, got %d", day)
+	}
+	ranges, err := bibleref.Parse(reading)
+	if err != nil {
+		return err
+	}
+	s[day] = append(s[day], bibleref.FormatRanges(ranges))
+	return nil
+}
+
+func (s schedule) days() []entities.ReadingPlanDay {
+	days := make([]entities.ReadingPlanDay, 0, len(s))
+	for day, readings := range s {
+		days = append(days, entities.ReadingPlanDay{Day: day, Readings: readings})
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Day < days[j].Day })
+	return days
+}
@@ -0,0 +1,120 @@
+package readingplan_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/readingplan"
+	"strings"
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	t.Run("should read the plan with its days in order", func(t *testing.T) {
+		plan, err := readingplan.ParseJSON(strings.NewReader(`{
+			"name": "La Biblia en un año",
+			"startDate": "2027-01-01",
+			"days": [
+				{"day": 2, "readings": ["Gn 3-4", "Mt 2"]},
+				{"day": 1, "readings": "Gn 1-2; Mt 1"}
+			]
+		}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "La Biblia en un año", plan.Name)
+		assert.Equal(t, "2027-01-01", plan.StartDate)
+		assert.Equal(t, []entities.ReadingPlanDay{
+			{Day: 1, Readings: []string{"Génesis 1-2; Mateo 1"}},
+			{Day: 2, Readings: []string{"Génesis 3-4", "Mateo 2"}},
+		}, plan.Schedule)
+	})
+
+	t.Run("should number a bare list of days after the previous one", func(t *testing.T) {
+		plan, err := readingplan.ParseJSON(strings.NewReader(`[
+			{"readings": ["Juan 1"]},
+			{"readings": ["Juan 2"]},
+			{"day": 5, "readings": ["Juan 3"]},
+			{"readings": ["Juan 4"]}
+		]`))
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 5, 6}, planDays(plan.Schedule))
+	})
+
+	t.Run("should reject a reading that does not parse", func(t *testing.T) {
+		_, err := readingplan.ParseJSON(strings.NewReader(`[{"day": 1, "readings": ["Xyz 3"]}]`))
+
+		assert.ErrorContains(t, err, "day 1")
+	})
+}
+
+func TestParseCSV(t *testing.T) {
+	t.Run("should read rows with a header, extra columns and repeated days", func(t *testing.T) {
+		plan, err := readingplan.ParseCSV(strings.NewReader("\xef\xbb\xbfdia,lectura\n1,Gn 1,Mt 1\n1,Sal 1\n\n2,Gn 2; Mt 2\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ReadingPlanDay{
+			{Day: 1, Readings: []string{"Génesis 1", "Mateo 1", "Salmos 1"}},
+			{Day: 2, Readings: []string{"Génesis 2; Mateo 2"}},
+		}, plan.Schedule)
+	})
+
+	t.Run("should detect tab separators", func(t *testing.T) {
+		plan, err := readingplan.ParseCSV(strings.NewReader("1\tGn 1\n2\tÉx 20, 22\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ReadingPlanDay{
+			{Day: 1, Readings: []string{"Génesis 1"}},
+			{Day: 2, Readings: []string{"Éxodo 20; 22"}},
+		}, plan.Schedule)
+	})
+
+	t.Run("should reject a day that is not a number past the header", func(t *testing.T) {
+		_, err := readingplan.ParseCSV(strings.NewReader("dia,lectura\nuno,Gn 1\n"))
+
+		assert.ErrorContains(t, err, "line 2")
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("should let the metadata override the file", func(t *testing.T) {
+		plan, err := readingplan.Parse(readingplan.FormatCSV, strings.NewReader("1,Gn 1\n"), readingplan.Metadata{Name: "Génesis", StartDate: "2027-01-01"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Génesis", plan.Name)
+		assert.Equal(t, "2027-01-01", plan.StartDate)
+	})
+
+	t.Run("should require a name", func(t *testing.T) {
+		_, err := readingplan.Parse(readingplan.FormatCSV, strings.NewReader("1,Gn 1\n"), readingplan.Metadata{})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject a start date that is not a date", func(t *testing.T) {
+		_, err := readingplan.Parse(readingplan.FormatJSON, strings.NewReader(`{"name": "Plan", "startDate": "01/01/2027", "days": [{"readings": "Gn 1"}]}`), readingplan.Metadata{})
+
+		assert.ErrorContains(t, err, "startDate")
+	})
+
+	t.Run("should reject a plan without readings", func(t *testing.T) {
+		_, err := readingplan.Parse(readingplan.FormatJSON, strings.NewReader(`{"name": "Plan", "days": []}`), readingplan.Metadata{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, readingplan.FormatCSV, readingplan.DetectFormat("plan.CSV"))
+	assert.Equal(t, readingplan.FormatCSV, readingplan.DetectFormat("plan.tsv"))
+	assert.Equal(t, readingplan.FormatJSON, readingplan.DetectFormat("plan.json"))
+	assert.Equal(t, readingplan.FormatJSON, readingplan.DetectFormat("plan"))
+}
+
+func planDays(schedule []entities.ReadingPlanDay) []int {
+	days := make([]int, 0, len(schedule))
+	for _, day := range schedule {
+		days = append(days, day.Day)
+	}
+	return days
+}
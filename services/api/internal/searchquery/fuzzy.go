@@ -0,0 +1,125 @@
+package searchquery
+
+import (
+	"services/api/domain/entities"
+	"unicode/utf8"
+)
+
+// MaxDistance is how many edits a word may be away from a vocabulary term
+// to count as a misspelling of it. Words of three letters or less are left
+// alone, almost anything is one edit away from them.
+func MaxDistance(word string) int {
+	switch n := utf8.RuneCountInString(word); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	}
+	return 2
+}
+
+// Distance counts the edits between a and b, where inserting, deleting or
+// replacing a letter and swapping two neighbouring letters each count as one.
+func Distance(a string, b string) int {
+	source, target := []rune(a), []rune(b)
+	rows := make([][]int, len(source)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(target)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			best := min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && source[i-1] == target[j-2] && source[i-2] == target[j-1] {
+				best = min(best, rows[i-2][j-2]+1)
+			}
+			rows[i][j] = best
+		}
+	}
+	return rows[len(source)][len(target)]
+}
+
+// Words lists the words of the query that candidates are looked up for,
+// with whether they are searched as a prefix. Excluded terms are left out.
+func Words(query entities.SearchQuery) map[string]bool {
+	words := map[string]bool{}
+	for _, group := range query.Groups {
+		for _, term := range group {
+			for i, word := range term.Words {
+				words[word] = words[word] || (term.Prefix && i == len(term.Words)-1)
+			}
+		}
+	}
+	return words
+}
+
+// Correct rewrites the query with candidates, the close vocabulary terms of
+// each word, closest first. A word with no exact candidate is taken as a
+// typo: on its own it becomes its candidates joined with OR, inside a phrase
+// it becomes the closest one. With expand, words spelled right are joined
+// with their candidates too. Suggestions lists the corrected words; changed
+// is false when the query was left as it was.
+func Correct(query entities.SearchQuery, candidates map[string][]entities.SearchCandidate, expand bool) (corrected entities.SearchQuery, suggestions []entities.SearchSuggestion, changed bool) {
+	corrected = entities.SearchQuery{Excluded: query.Excluded, Books: query.Books, Fuzzy: query.Fuzzy}
+	suggested := map[string]bool{}
+	suggest := func(word string, found []entities.SearchCandidate) {
+		if suggested[word] {
+			return
+		}
+		suggested[word] = true
+		terms := make([]string, 0, len(found))
+		for _, candidate := range found {
+			terms = append(terms, candidate.Term)
+		}
+		suggestions = append(suggestions, entities.SearchSuggestion{Term: word, Suggestions: terms})
+	}
+
+	for _, group := range query.Groups {
+		next := make([]entities.SearchTerm, 0, len(group))
+		for _, term := range group {
+			if len(term.Words) == 1 {
+				found := candidates[term.Words[0]]
+				known := len(found) > 0 && found[0].Distance == 0
+				if len(found) == 0 || (known && !expand) {
+					next = append(next, term)
+					continue
+				}
+				if known {
+					next = append(next, term)
+				} else {
+					suggest(term.Words[0], found)
+				}
+				for _, candidate := range found {
+					if candidate.Distance > 0 {
+						next = append(next, entities.SearchTerm{Words: []string{candidate.Term}})
+						changed = true
+					}
+				}
+				continue
+			}
+
+			words := make([]string, len(term.Words))
+			copy(words, term.Words)
+			for i, word := range words {
+				found := candidates[word]
+				if len(found) == 0 || found[0].Distance == 0 {
+					continue
+				}
+				suggest(word, found)
+				words[i] = found[0].Term
+				changed = true
+			}
+			next = append(next, entities.SearchTerm{Words: words, Prefix: term.Prefix})
+		}
+		corrected.Groups = append(corrected.Groups, next)
+	}
+	return corrected, suggestions, changed
+}
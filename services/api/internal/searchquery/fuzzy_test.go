@@ -0,0 +1,108 @@
+package searchquery_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"services/api/domain/entities"
+	"services/api/internal/searchquery"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "gracia", b: "gracia", expected: 0},
+		{a: "", b: "", expected: 0},
+		{a: "", b: "amor", expected: 4},
+		{a: "amor", b: "", expected: 4},
+		{a: "graica", b: "gracia", expected: 1},
+		{a: "agracia", b: "gracia", expected: 1},
+		{a: "misericorida", b: "misericordia", expected: 1},
+		{a: "jeuss", b: "jesus", expected: 1},
+		{a: "corazon", b: "corazón", expected: 1},
+		{a: "ñandú", b: "nandu", expected: 2},
+		{a: "pastor", b: "pastora", expected: 1},
+		{a: "paz", b: "amor", expected: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, searchquery.Distance(tt.a, tt.b))
+			assert.Equal(t, tt.expected, searchquery.Distance(tt.b, tt.a))
+		})
+	}
+}
+
+func TestMaxDistance(t *testing.T) {
+	assert.Equal(t, 0, searchquery.MaxDistance(""))
+	assert.Equal(t, 0, searchquery.MaxDistance("paz"))
+	assert.Equal(t, 1, searchquery.MaxDistance("señor"))
+	assert.Equal(t, 1, searchquery.MaxDistance("óóóóóó"))
+	assert.Equal(t, 2, searchquery.MaxDistance("gracias"))
+}
+
+func TestCorrect(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       entities.SearchQuery
+		candidates  map[string][]entities.SearchCandidate
+		expand      bool
+		expected    entities.SearchQuery
+		suggestions []entities.SearchSuggestion
+		changed     bool
+	}{
+		{
+			name:       "should leave words spelled right",
+			query:      entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia")}}},
+			candidates: map[string][]entities.SearchCandidate{"gracia": {{Term: "gracia"}, {Term: "gracias", Distance: 1}}},
+			expected:   entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia")}}},
+		},
+		{
+			name:        "should swap a typo for its candidates joined with OR, ties in order",
+			query:       entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("pastro")}, {prefix("paz")}}, Excluded: []entities.SearchTerm{prefix("lobo")}, Books: [][]string{{"salmos"}}},
+			candidates:  map[string][]entities.SearchCandidate{"pastro": {{Term: "pastor", Distance: 1}, {Term: "astro", Distance: 1}}},
+			expected:    entities.SearchQuery{Groups: [][]entities.SearchTerm{{phrase("pastor"), phrase("astro")}, {prefix("paz")}}, Excluded: []entities.SearchTerm{prefix("lobo")}, Books: [][]string{{"salmos"}}},
+			suggestions: []entities.SearchSuggestion{{Term: "pastro", Suggestions: []string{"pastor", "astro"}}},
+			changed:     true,
+		},
+		{
+			name:        "should take the first of tied candidates inside a phrase",
+			query:       entities.SearchQuery{Groups: [][]entities.SearchTerm{{phrase("no", "temsa")}}},
+			candidates:  map[string][]entities.SearchCandidate{"temsa": {{Term: "temas", Distance: 1}, {Term: "temes", Distance: 1}}},
+			expected:    entities.SearchQuery{Groups: [][]entities.SearchTerm{{phrase("no", "temas")}}},
+			suggestions: []entities.SearchSuggestion{{Term: "temsa", Suggestions: []string{"temas", "temes"}}},
+			changed:     true,
+		},
+		{
+			name:        "should suggest a word once however often it is searched",
+			query:       entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("corazon")}, {phrase("mi", "corazon")}}},
+			candidates:  map[string][]entities.SearchCandidate{"corazon": {{Term: "corazón", Distance: 1}}},
+			expected:    entities.SearchQuery{Groups: [][]entities.SearchTerm{{phrase("corazón")}, {phrase("mi", "corazón")}}},
+			suggestions: []entities.SearchSuggestion{{Term: "corazon", Suggestions: []string{"corazón"}}},
+			changed:     true,
+		},
+		{
+			name:       "should join words spelled right with their candidates when expanding",
+			query:      entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia")}}},
+			candidates: map[string][]entities.SearchCandidate{"gracia": {{Term: "gracia"}, {Term: "gracias", Distance: 1}}},
+			expand:     true,
+			expected:   entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia"), phrase("gracias")}}},
+			changed:    true,
+		},
+		{
+			name:     "should leave an empty query alone",
+			query:    entities.SearchQuery{},
+			expected: entities.SearchQuery{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corrected, suggestions, changed := searchquery.Correct(tt.query, tt.candidates, tt.expand)
+
+			assert.Equal(t, tt.expected, corrected)
+			assert.Equal(t, tt.suggestions, suggestions)
+			assert.Equal(t, tt.changed, changed)
+		})
+	}
+}
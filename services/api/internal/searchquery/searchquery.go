@@ -0,0 +1,193 @@
+package searchquery
+
+import (
+	"errors"
+	"fmt"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"strings"
+	"unicode"
+)
+
+// maxTerms bounds the size of the compiled query.
+const maxTerms = 16
+
+type token struct {
+	text    string
+	negated bool
+}
+
+// Parse reads a verse search such as
+//
+//	"no temas" gracia OR misericordia -muerte libro:salmos-proverbios
+//
+// Words are ANDed; OR (or |) joins the terms on both sides into one group;
+// a leading - excludes a word or phrase; quotes make a phrase. Filters are
+// libro:<book> (also book:), a book range like libro:mateo-juan, and at: or
+// nt: for a testament. Several filters narrow each other, so nt: libro:juan
+// searches John alone. Book names go through bibleref.LookupBook, so
+// abbreviations and English names work too.
+func Parse(input string) (entities.SearchQuery, error) {
+	query := entities.SearchQuery{}
+	joinNext := false
+	terms := 0
+
+	for _, tok := range tokenize(input) {
+		if !tok.negated && (tok.text == "OR" || tok.text == "|") {
+			joinNext = len(query.Groups) > 0
+			continue
+		}
+
+		if key, value, ok := strings.Cut(tok.text, ":"); ok && !strings.HasPrefix(tok.text, `"`) {
+			filter, rest, err := parseFilter(strings.ToLower(key), value)
+			switch {
+			case errors.Is(err, errNotFilter):
+			case err != nil:
+				return entities.SearchQuery{}, err
+			default:
+				query.Books = append(query.Books, filter)
+				tok.text = rest
+			}
+		}
+
+		for _, term := range parseTerms(tok.text) {
+			terms++
+			switch {
+			case tok.negated:
+				query.Excluded = append(query.Excluded, term)
+			case joinNext:
+				last := len(query.Groups) - 1
+				query.Groups[last] = append(query.Groups[last], term)
+				joinNext = false
+			default:
+				query.Groups = append(query.Groups, []entities.SearchTerm{term})
+			}
+		}
+		joinNext = false
+	}
+
+	if len(query.Groups) == 0 {
+		return entities.SearchQuery{}, errors.New("query needs at least one word to search for")
+	}
+	if terms > maxTerms {
+		return entities.SearchQuery{}, fmt.Errorf("query has %d terms, the limit is %d", terms, maxTerms)
+	}
+	return query, nil
+}
+
+// tokenize splits on spaces that are not inside quotes and records a
+// leading "-" as negation.
+func tokenize(input string) []token {
+	var (
+		tokens  []token
+		current strings.Builder
+		quoted  bool
+	)
+	flush := func() {
+		text := current.String()
+		current.Reset()
+		negated := false
+		if len(text) > 1 && text[0] == '-' {
+			negated, text = true, text[1:]
+		}
+		if text != "" && text != "-" {
+			tokens = append(tokens, token{text: text, negated: negated})
+		}
+	}
+	for _, r := range input {
+		switch {
+		case r == '"' || r == '“' || r == '”':
+			quoted = !quoted
+			current.WriteRune('"')
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// parseTerms turns one token into terms. A quoted token is one phrase; a
+// bare token becomes one prefix term per word, skipping one-letter words.
+func parseTerms(text string) []entities.SearchTerm {
+	if strings.HasPrefix(text, `"`) {
+		words := splitWords(text)
+		if len(words) == 0 {
+			return nil
+		}
+		return []entities.SearchTerm{{Words: words}}
+	}
+
+	var terms []entities.SearchTerm
+	for _, word := range splitWords(text) {
+		if len([]rune(word)) < 2 {
+			continue
+		}
+		terms = append(terms, entities.SearchTerm{Words: []string{word}, Prefix: true})
+	}
+	return terms
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsNumber(r))
+	})
+}
+
+var errNotFilter = errors.New("not a filter")
+
+// parseFilter returns the books selected by a key:value filter and the text
+// left to search for: at:amor searches "amor" in the Old Testament. It
+// returns errNotFilter when key is not a filter name, so "juan:3" is read as
+// plain words.
+func parseFilter(key string, value string) ([]string, string, error) {
+	switch key {
+	case "at":
+		return consts.BookOrder[:consts.OldTestamentBooks], value, nil
+	case "nt":
+		return consts.BookOrder[consts.OldTestamentBooks:], value, nil
+	case "libro", "book":
+	default:
+		return nil, "", errNotFilter
+	}
+
+	value = strings.Trim(value, `"`)
+	if slug, err := bibleref.LookupBook(value); err == nil {
+		return []string{slug}, "", nil
+	}
+	for i := strings.Index(value, "-"); i >= 0; {
+		first, firstErr := bibleref.LookupBook(value[:i])
+		last, lastErr := bibleref.LookupBook(value[i+1:])
+		if firstErr == nil && lastErr == nil {
+			return bookRange(first, last), "", nil
+		}
+		next := strings.Index(value[i+1:], "-")
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	_, err := bibleref.LookupBook(value)
+	return nil, "", fmt.Errorf("libro:%s: %w", value, err)
+}
+
+// bookRange lists the books from first to last in canonical order, in
+// either direction.
+func bookRange(first string, last string) []string {
+	start, end := -1, -1
+	for i, slug := range consts.BookOrder {
+		if slug == first {
+			start = i
+		}
+		if slug == last {
+			end = i
+		}
+	}
+	if start > end {
+		start, end = end, start
+	}
+	return consts.BookOrder[start : end+1]
+}
@@ -0,0 +1,112 @@
+package searchquery_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"services/api/internal/searchquery"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected entities.SearchQuery
+	}{
+		{
+			name:     "should and the words",
+			input:    "  Gracia   y PAZ ",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia")}, {prefix("paz")}}},
+		},
+		{
+			name:     "should read quoted phrases, curly quotes too",
+			input:    `"No temas" “porque yo”`,
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{phrase("no", "temas")}, {phrase("porque", "yo")}}},
+		},
+		{
+			name:     "should join the terms around OR",
+			input:    "gracia OR misericordia | amor paz",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("gracia"), prefix("misericordia"), prefix("amor")}, {prefix("paz")}}},
+		},
+		{
+			name:     "should exclude negated words and phrases",
+			input:    `amor -odio -"ojo por ojo"`,
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}}, Excluded: []entities.SearchTerm{prefix("odio"), phrase("ojo", "por", "ojo")}},
+		},
+		{
+			name:     "should filter by book and book range",
+			input:    "libro:salmos pastor book:mt-jn",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("pastor")}}, Books: [][]string{{"salmos"}, {"mateo", "marcos", "lucas", "juan"}}},
+		},
+		{
+			name:     "should filter by a book name with a dash and a quoted one",
+			input:    `libro:1-juan libro:"cantares" amor`,
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}}, Books: [][]string{{"1-juan"}, {"cantares"}}},
+		},
+		{
+			name:     "should filter by testament and search the rest of the token",
+			input:    "NT:amor at:",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}}, Books: [][]string{consts.BookOrder[consts.OldTestamentBooks:], consts.BookOrder[:consts.OldTestamentBooks]}},
+		},
+		{
+			name:     "should read a reversed book range in canonical order",
+			input:    "libro:juan-mateo amor",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}}, Books: [][]string{{"mateo", "marcos", "lucas", "juan"}}},
+		},
+		{
+			name:     "should close a quote left open at the end",
+			input:    `amor "no temas`,
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}, {phrase("no", "temas")}}},
+		},
+		{
+			name:     "should drop a bare dash, a lone OR and one-letter words",
+			input:    "OR - a amor OR",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("amor")}}},
+		},
+		{
+			name:     "should read an unknown filter key as words",
+			input:    "juan:3 autor:pablo",
+			expected: entities.SearchQuery{Groups: [][]entities.SearchTerm{{prefix("juan")}, {prefix("autor")}, {prefix("pablo")}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := searchquery.Parse(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, query)
+		})
+	}
+
+	t.Run("should reject malformed queries", func(t *testing.T) {
+		bad := []struct {
+			input    string
+			expected string
+		}{
+			{input: "", expected: "query needs at least one word"},
+			{input: "-", expected: "query needs at least one word"},
+			{input: `"" a -amor`, expected: "query needs at least one word"},
+			{input: "libro:juan", expected: "query needs at least one word"},
+			{input: "libro:hechos-xyz amor", expected: "libro:hechos-xyz"},
+			{input: "book: amor", expected: "libro:"},
+			{input: "uno dos tres cuatro cinco seis siete ocho nueve diez once doce trece catorce quince dieciseis diecisiete", expected: "query has 17 terms, the limit is 16"},
+		}
+		for _, tt := range bad {
+			_, err := searchquery.Parse(tt.input)
+
+			assert.ErrorContains(t, err, tt.expected, tt.input)
+		}
+	})
+}
+
+// prefix is a single word searched as a prefix.
+func prefix(word string) entities.SearchTerm {
+	return entities.SearchTerm{Words: []string{word}, Prefix: true}
+}
+
+// phrase is an exact phrase.
+func phrase(words ...string) entities.SearchTerm {
+	return entities.SearchTerm{Words: words}
+}
@@ -0,0 +1,153 @@
+package slides
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Default budget when the request gives none: about four lines of a
+// 1080p slide at the default verse size.
+const defaultMaxChars = 220
+
+// charWidth approximates the average glyph width of a proportional font as
+// a share of its size, and defaultLineHeight matches VerseStyles.
+const (
+	charWidth         = 0.5
+	defaultLineHeight = 1.2
+)
+
+// Budget bounds what fits on one slide. MaxChars counts runes; MaxLines
+// counts lines once the text wraps at CharsPerLine. Zero fields are not
+// checked.
+type Budget struct {
+	MaxChars     int
+	MaxLines     int
+	CharsPerLine int
+}
+
+// Metrics describes the text area in pixels, as in VerseStyles, for callers
+// that know the layout but not a character budget.
+type Metrics struct {
+	FontSize   float64
+	LineHeight float64
+	Width      float64
+	Height     float64
+}
+
+// NewBudget fills the missing parts of a budget from the metrics: the
+// characters per line from the width and font size, the lines from the
+// height. With nothing to go on it falls back to defaultMaxChars.
+func NewBudget(budget Budget, metrics Metrics) Budget {
+	if metrics.FontSize > 0 {
+		lineHeight := metrics.LineHeight
+		if lineHeight <= 0 {
+			lineHeight = defaultLineHeight
+		}
+		if budget.CharsPerLine <= 0 && metrics.Width > 0 {
+			budget.CharsPerLine = int(metrics.Width / (metrics.FontSize * charWidth))
+		}
+		if budget.MaxLines <= 0 && metrics.Height > 0 {
+			budget.MaxLines = int(metrics.Height / (metrics.FontSize * lineHeight))
+		}
+	}
+	if budget.MaxLines > 0 && budget.CharsPerLine <= 0 {
+		budget.MaxLines = 0
+	}
+	if budget.MaxChars <= 0 && budget.MaxLines <= 0 {
+		budget.MaxChars = defaultMaxChars
+	}
+	return budget
+}
+
+// Fits reports whether text stays within the budget.
+func (b Budget) Fits(text string) bool {
+	if b.MaxChars > 0 && utf8.RuneCountInString(text) > b.MaxChars {
+		return false
+	}
+	if b.MaxLines > 0 && b.CharsPerLine > 0 && wrappedLines(strings.Fields(text), b.CharsPerLine) > b.MaxLines {
+		return false
+	}
+	return true
+}
+
+// Split cuts text into chunks that fit the budget. It fills each chunk as
+// far as it can, then steps back to the last sentence end (. ! ? ; :) or,
+// failing that, the last clause end (, or a dash) in the second half of
+// the chunk, so the reader does not lose the thread. Text is only ever cut
+// between words; a single word longer than the budget gets a chunk of its
+// own.
+func Split(text string, budget Budget) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var chunks []string
+	for start := 0; start < len(words); {
+		end := start + 1
+		for end < len(words) && budget.Fits(strings.Join(words[start:end+1], " ")) {
+			end++
+		}
+		if end < len(words) {
+			end = breakPoint(words, start, end)
+		}
+		chunks = append(chunks, strings.Join(words[start:end], " "))
+		start = end
+	}
+	return chunks
+}
+
+// Label numbers a chunk of a reference, "Juan 3:16 (1/2)", leaving single
+// chunks as the bare reference.
+func Label(reference string, part int, parts int) string {
+	if parts <= 1 {
+		return reference
+	}
+	return fmt.Sprintf("%s (%d/%d)", reference, part, parts)
+}
+
+// breakPoint picks where a full chunk words[start:end] should end.
+func breakPoint(words []string, start int, end int) int {
+	earliest := start + (end-start+1)/2
+	for _, isBreak := range []func(string) bool{endsSentence, endsClause} {
+		for candidate := end; candidate > start && candidate >= earliest; candidate-- {
+			if isBreak(words[candidate-1]) {
+				return candidate
+			}
+		}
+	}
+	return end
+}
+
+var closingMarks = `"'”’»)]`
+
+func endsSentence(word string) bool {
+	word = strings.TrimRight(word, closingMarks)
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?") ||
+		strings.HasSuffix(word, ";") || strings.HasSuffix(word, ":")
+}
+
+func endsClause(word string) bool {
+	word = strings.TrimRight(word, closingMarks)
+	return strings.HasSuffix(word, ",") || strings.HasSuffix(word, "—") || strings.HasSuffix(word, "–") || word == "-"
+}
+
+// wrappedLines counts the lines words take when wrapped at width runes.
+func wrappedLines(words []string, width int) int {
+	lines, current := 0, 0
+	for _, word := range words {
+		size := utf8.RuneCountInString(word)
+		switch {
+		case current == 0:
+			lines++
+			current = size
+		case current+1+size <= width:
+			current += 1 + size
+		default:
+			lines++
+			current = size
+		}
+	}
+	return lines
+}
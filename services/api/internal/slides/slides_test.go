@@ -0,0 +1,114 @@
+package slides_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"services/api/internal/slides"
+	"strings"
+	"testing"
+)
+
+func TestNewBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		budget   slides.Budget
+		metrics  slides.Metrics
+		expected slides.Budget
+	}{
+		{name: "should fall back to the default characters", expected: slides.Budget{MaxChars: 220}},
+		{name: "should keep a budget that was given", budget: slides.Budget{MaxChars: 80}, expected: slides.Budget{MaxChars: 80}},
+		{
+			name:     "should work the lines out of the metrics",
+			metrics:  slides.Metrics{FontSize: 50, Width: 1000, Height: 300},
+			expected: slides.Budget{MaxLines: 5, CharsPerLine: 40},
+		},
+		{
+			name:     "should use the line height of the metrics",
+			metrics:  slides.Metrics{FontSize: 50, LineHeight: 1.5, Width: 1000, Height: 300},
+			expected: slides.Budget{MaxLines: 4, CharsPerLine: 40},
+		},
+		{
+			name:     "should drop lines that cannot be wrapped",
+			budget:   slides.Budget{MaxLines: 3},
+			expected: slides.Budget{MaxChars: 220},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, slides.NewBudget(tt.budget, tt.metrics))
+		})
+	}
+}
+
+func TestBudget_Fits(t *testing.T) {
+	t.Run("should count runes, not bytes", func(t *testing.T) {
+		budget := slides.Budget{MaxChars: 6}
+
+		assert.True(t, budget.Fits("señora"))
+		assert.False(t, budget.Fits("señoras"))
+	})
+
+	t.Run("should count the lines once the text wraps", func(t *testing.T) {
+		budget := slides.Budget{MaxLines: 2, CharsPerLine: 10}
+
+		assert.True(t, budget.Fits("Porque de tal manera"))
+		assert.False(t, budget.Fits("Porque de tal manera amó"))
+	})
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		budget   slides.Budget
+		expected []string
+	}{
+		{
+			name:     "should leave a text that fits whole",
+			text:     "  Jesús  lloró. ",
+			budget:   slides.Budget{MaxChars: 40},
+			expected: []string{"Jesús lloró."},
+		},
+		{
+			name:     "should step back to the end of a sentence, else of a clause",
+			text:     "En el principio era el Verbo. Y el Verbo era con Dios, y el Verbo era Dios.",
+			budget:   slides.Budget{MaxChars: 40},
+			expected: []string{"En el principio era el Verbo.", "Y el Verbo era con Dios,", "y el Verbo era Dios."},
+		},
+		{
+			name:     "should step back to the end of a clause",
+			text:     "Venid a mí todos los que estáis trabajados y cargados, y yo os haré descansar",
+			budget:   slides.Budget{MaxChars: 60},
+			expected: []string{"Venid a mí todos los que estáis trabajados y cargados,", "y yo os haré descansar"},
+		},
+		{
+			name:     "should not step back past the middle of the chunk",
+			text:     "Dios. Porque de tal manera amó Dios al mundo que ha dado a su Hijo",
+			budget:   slides.Budget{MaxChars: 40},
+			expected: []string{"Dios. Porque de tal manera amó Dios al", "mundo que ha dado a su Hijo"},
+		},
+		{
+			name:     "should give a word longer than the budget a chunk of its own",
+			text:     "Maher-salal-hasbaz nació",
+			budget:   slides.Budget{MaxChars: 10},
+			expected: []string{"Maher-salal-hasbaz", "nació"},
+		},
+		{name: "should return nothing for a blank text", text: " \n ", budget: slides.Budget{MaxChars: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := slides.Split(tt.text, tt.budget)
+
+			assert.Equal(t, tt.expected, chunks)
+			for _, chunk := range chunks {
+				if strings.Contains(chunk, " ") {
+					assert.True(t, tt.budget.Fits(chunk), chunk)
+				}
+			}
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	assert.Equal(t, "Juan 3:16", slides.Label("Juan 3:16", 1, 1))
+	assert.Equal(t, "Juan 3:16 (2/3)", slides.Label("Juan 3:16", 2, 3))
+}
@@ -0,0 +1,126 @@
+package songs
+
+import (
+	"errors"
+	"fmt"
+	"services/api/domain/entities"
+	"strings"
+)
+
+// ErrUnknownArrangement is returned for an arrangement the song does not have.
+var ErrUnknownArrangement = errors.New("unknown arrangement")
+
+// importedArrangement names the arrangement read from a song file.
+const importedArrangement = "Original"
+
+// Arrange lays out the segments of song in the order of the arrangement
+// with id, or of its default arrangement when id is empty, and returns that
+// arrangement. A song without arrangements is sung as its segments are
+// listed, and nil is returned for the arrangement.
+func Arrange(song entities.LyricsSong, id string) ([]entities.LyricsSegment, *entities.LyricsArrangement, error) {
+	requested := id != ""
+	if !requested {
+		id = song.DefaultArrangement
+	}
+	for i, arrangement := range song.Arrangements {
+		if arrangement.ID == id {
+			return expandSequence(song.Segments, arrangement.Sequence), &song.Arrangements[i], nil
+		}
+	}
+	if requested {
+		return nil, nil, ErrUnknownArrangement
+	}
+	return song.Segments, nil, nil
+}
+
+// expandSequence turns segment ids into the segments, repeats included.
+// Ids that name no segment are skipped.
+func expandSequence(segments []entities.LyricsSegment, sequence []string) []entities.LyricsSegment {
+	byID := make(map[string]entities.LyricsSegment, len(segments))
+	for _, segment := range segments {
+		if _, ok := byID[segment.ID]; !ok {
+			byID[segment.ID] = segment
+		}
+	}
+	slides := make([]entities.LyricsSegment, 0, len(sequence))
+	for _, id := range sequence {
+		if segment, ok := byID[id]; ok {
+			slides = append(slides, segment)
+		}
+	}
+	return slides
+}
+
+// SyncArrangements keeps the arrangements of a song in step with its
+// segments. Ids of removed segments are dropped from every sequence, and
+// the arrangements left empty with them. Arrangements without an id or a
+// name get one, and the default always names an arrangement, the first
+// one unless another was chosen.
+func SyncArrangements(song *entities.LyricsSongPayload) {
+	segments := make(map[string]bool, len(song.Segments))
+	for _, segment := range song.Segments {
+		segments[segment.ID] = true
+	}
+
+	kept := make([]entities.LyricsArrangement, 0, len(song.Arrangements))
+	taken := map[string]bool{}
+	for _, arrangement := range song.Arrangements {
+		sequence := make([]string, 0, len(arrangement.Sequence))
+		for _, id := range arrangement.Sequence {
+			if segments[id] {
+				sequence = append(sequence, id)
+			}
+		}
+		if len(sequence) == 0 {
+			continue
+		}
+		arrangement.ID = strings.TrimSpace(arrangement.ID)
+		if taken[arrangement.ID] {
+			arrangement.ID = ""
+		}
+		taken[arrangement.ID] = arrangement.ID != ""
+		arrangement.Name = collapse(arrangement.Name)
+		arrangement.Sequence = sequence
+		kept = append(kept, arrangement)
+	}
+
+	next := 0
+	hasDefault := false
+	for i := range kept {
+		for kept[i].ID == "" {
+			next++
+			if id := fmt.Sprintf("a%d", next); !taken[id] {
+				kept[i].ID = id
+				taken[id] = true
+			}
+		}
+		if kept[i].Name == "" {
+			kept[i].Name = fmt.Sprintf("Arreglo %d", i+1)
+		}
+		hasDefault = hasDefault || kept[i].ID == song.DefaultArrangement
+	}
+	song.Arrangements = kept
+	if !hasDefault {
+		song.DefaultArrangement = ""
+		if len(kept) > 0 {
+			song.DefaultArrangement = kept[0].ID
+		}
+	}
+}
+
+// setImportedArrangement gives an imported song the order its file sings
+// the segments in as its default arrangement. Nothing is added when that
+// order is just the segments as listed.
+func setImportedArrangement(song *entities.LyricsSongPayload, sequence []string) {
+	if len(sequence) == len(song.Segments) {
+		same := true
+		for i, id := range sequence {
+			same = same && song.Segments[i].ID == id
+		}
+		if same {
+			return
+		}
+	}
+	song.Arrangements = []entities.LyricsArrangement{{ID: "a1", Name: importedArrangement, Sequence: sequence}}
+	song.DefaultArrangement = "a1"
+}
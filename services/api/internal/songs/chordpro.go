@@ -0,0 +1,568 @@
+package songs
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// chordProExts are the extensions of ChordPro files.
+var chordProExts = []string{".cho", ".chordpro", ".chopro", ".crd", ".pro"}
+
+// chordProEnvironments maps the ChordPro sections onto segment kinds. The
+// ones missing here, like tabs and grids, hold no lyrics and are skipped.
+var chordProEnvironments = map[string]string{
+	"verse":  consts.SegmentVerse,
+	"v":      consts.SegmentVerse,
+	"chorus": consts.SegmentChorus,
+	"c":      consts.SegmentChorus,
+	"bridge": consts.SegmentBridge,
+	"b":      consts.SegmentBridge,
+}
+
+// chordProSections is the reverse of chordProEnvironments, used on export.
+// Intros and outros are written as labelled verses.
+var chordProSections = map[string]string{
+	consts.SegmentVerse:  "verse",
+	consts.SegmentChorus: "chorus",
+	consts.SegmentBridge: "bridge",
+}
+
+// ChordOptions tells how to write the chords of a song. Key transposes the
+// song to that key, else Transpose moves it by semitones. With a Capo the
+// chords are the shapes played on the capoed guitar. The sections follow
+// Arrangement, or the default arrangement when it is empty.
+type ChordOptions struct {
+	Key         string
+	Transpose   int
+	Capo        int
+	Arrangement string
+}
+
+type chordProSection struct {
+	kind   string
+	title  string
+	lines  []string
+	repeat int
+}
+
+// IsChordProFile tells from its extension whether name is a ChordPro file.
+func IsChordProFile(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	for _, known := range chordProExts {
+		if ext == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseChordPro reads one ChordPro song. Every section, or stanza between
+// blank lines, becomes a segment whose Content is the lyrics and whose
+// Chords keeps the chorded lines. A section written again and {chorus},
+// which repeats the last chorus, are sung in the default arrangement. Songs
+// written for a capo are stored in the key they sound in. Sections without
+// lyrics, like tabs or a chords-only intro, are left out.
+func ParseChordPro(r io.Reader) (*entities.LyricsSongPayload, error) {
+	song, err := parseChordPro(r)
+	if err != nil {
+		return nil, err
+	}
+	if song.Title == "" {
+		return nil, errors.New("song has no title")
+	}
+	return song, nil
+}
+
+func parseChordPro(r io.Reader) (*entities.LyricsSongPayload, error) {
+	var (
+		song     entities.LyricsSongPayload
+		sections []chordProSection
+		current  *chordProSection
+		inside   bool
+		skipping bool
+		label    string
+		capo     int
+	)
+	closeSection := func() {
+		if current != nil && len(current.lines) > 0 {
+			sections = append(sections, *current)
+		}
+		current, inside = nil, false
+	}
+	openSection := func(kind string, title string) {
+		closeSection()
+		if sectionKind, ok := SectionKind(title); ok && kind == consts.SegmentVerse {
+			kind = sectionKind
+		}
+		current = &chordProSection{kind: kind, title: title, repeat: -1}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for first := true; scanner.Scan(); first = false {
+		line := scanner.Text()
+		if first {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		trimmed := strings.TrimSpace(line)
+		if !utf8.ValidString(trimmed) {
+			return nil, errors.New("chordpro file is not utf-8")
+		}
+
+		if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
+			name, value := chordProDirective(trimmed)
+			if skipping {
+				skipping = !strings.HasPrefix(name, "end_of_") && !strings.HasPrefix(name, "eo")
+				continue
+			}
+			switch name {
+			case "title", "t":
+				if song.Title == "" {
+					song.Title = value
+				}
+			case "artist", "composer", "lyricist", "copyright", "ccli":
+				addChordProMeta(&song, name, value)
+			case "meta":
+				metaName, metaValue, _ := strings.Cut(value, " ")
+				addChordProMeta(&song, strings.ToLower(metaName), strings.TrimSpace(metaValue))
+			case "capo":
+				capo, _ = strconv.Atoi(value)
+			case "key", "tempo":
+				addChordProMeta(&song, name, value)
+			case "soc", "sov", "sob":
+				openSection(chordProEnvironments[name[2:]], value)
+				inside = true
+			case "eoc", "eov", "eob":
+				closeSection()
+			case "chorus":
+				closeSection()
+				for i := len(sections) - 1; i >= 0; i-- {
+					if sections[i].kind == consts.SegmentChorus && sections[i].repeat < 0 {
+						sections = append(sections, chordProSection{kind: consts.SegmentChorus, repeat: i})
+						break
+					}
+				}
+			case "comment", "c", "ci", "cb", "comment_italic", "comment_box", "highlight":
+				if _, ok := SectionKind(value); ok && !inside {
+					closeSection()
+					label = value
+				}
+			default:
+				switch {
+				case strings.HasPrefix(name, "start_of_"):
+					environment := strings.TrimPrefix(name, "start_of_")
+					kind, ok := chordProEnvironments[environment]
+					if !ok {
+						if kind, ok = SectionKind(environment); !ok {
+							skipping = true
+							continue
+						}
+					}
+					openSection(kind, value)
+					inside = true
+				case strings.HasPrefix(name, "end_of_"):
+					closeSection()
+				}
+			}
+			continue
+		}
+		if skipping || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if trimmed == "" {
+			if inside {
+				current.lines = append(current.lines, "")
+			} else {
+				closeSection()
+			}
+			continue
+		}
+		if current == nil {
+			openSection(consts.SegmentVerse, label)
+			label = ""
+		}
+		current.lines = append(current.lines, trimmed)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid chordpro file: %w", err)
+	}
+	closeSection()
+
+	shift, flats := 0, false
+	if capo > 0 {
+		shift = capo
+		_, flats = transposeKey(firstChordKey(sections), capo)
+		if song.Key != "" {
+			song.Key, _ = transposeKey(song.Key, capo)
+		}
+	}
+
+	counts := map[string]int{}
+	written := map[string]string{}
+	read := make([]string, len(sections))
+	sequence := []string{}
+	texts := []string{}
+	for i, section := range sections {
+		if section.repeat >= 0 {
+			if id := read[section.repeat]; id != "" {
+				sequence = append(sequence, id)
+			}
+			continue
+		}
+
+		for j, line := range section.lines {
+			section.lines[j] = TransposeLine(line, shift, flats)
+		}
+		chords := joinLines(section.lines)
+		content := StripChords(chords)
+		if content == "" {
+			continue
+		}
+		key := section.kind + "\x00" + chords
+		if id, ok := written[key]; ok {
+			read[i] = id
+			sequence = append(sequence, id)
+			continue
+		}
+		letter := verseLetters[section.kind]
+		counts[letter]++
+		title := section.title
+		if title == "" {
+			title = defaultSectionTitle(section.kind, counts[letter])
+		}
+		segment := newSegment(fmt.Sprintf("%s%d", letter, counts[letter]), title, section.kind, content)
+		if HasChords(chords) {
+			segment.Chords = chords
+		}
+		written[key] = segment.ID
+		read[i] = segment.ID
+		sequence = append(sequence, segment.ID)
+		song.Segments = append(song.Segments, segment)
+		texts = append(texts, content)
+	}
+	if len(song.Segments) == 0 {
+		return nil, errors.New("song has no lyrics")
+	}
+	song.Lyrics = strings.Join(texts, "\n\n")
+	setImportedArrangement(&song, sequence)
+	return &song, nil
+}
+
+// chordProDirective splits "{name: value}" into its lowercase name and its
+// value. The colon may be left out: "{title Sublime gracia}".
+func chordProDirective(line string) (string, string) {
+	inner := strings.TrimSpace(line[1 : len(line)-1])
+	name, value, found := strings.Cut(inner, ":")
+	if !found {
+		name, value, _ = strings.Cut(inner, " ")
+	}
+	return strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value)
+}
+
+func addChordProMeta(song *entities.LyricsSongPayload, name string, value string) {
+	if value == "" {
+		return
+	}
+	switch name {
+	case "title":
+		if song.Title == "" {
+			song.Title = value
+		}
+	case "artist", "composer", "lyricist":
+		for _, author := range song.Authors {
+			if author == value {
+				return
+			}
+		}
+		song.Authors = append(song.Authors, value)
+	case "copyright":
+		song.Copyright = value
+	case "ccli":
+		song.CCLI = value
+	case "key":
+		if key, err := NormalizeKey(value); err == nil {
+			song.Key = key
+		}
+	case "tempo":
+		if tempo, err := strconv.Atoi(value); err == nil && tempo > 0 {
+			song.Tempo = tempo
+		}
+	case "keywords", "tag", "tags":
+		for _, tag := range strings.Split(value, ",") {
+			if tag = collapse(tag); tag != "" {
+				song.Tags = append(song.Tags, tag)
+			}
+		}
+	}
+}
+
+func firstChordKey(sections []chordProSection) string {
+	for _, section := range sections {
+		for _, line := range section.lines {
+			for _, match := range inlineChord.FindAllStringSubmatch(line, -1) {
+				if key := ChordKey(match[1]); key != "" {
+					return key
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// defaultSectionTitle names a section that came without a label.
+func defaultSectionTitle(kind string, number int) string {
+	if kind == consts.SegmentVerse {
+		return fmt.Sprintf("%s %d", kind, number)
+	}
+	return kind
+}
+
+// ReadChordPro reads an uploaded ChordPro file, or a zip of them, like
+// ReadOpenLyrics. A song without a {title} takes the name of its file.
+func ReadChordPro(name string, content []byte) ([]entities.LyricsImportFile, error) {
+	return readSongFiles(name, content, "chordpro", IsChordProFile, func(file string, r io.Reader) (*entities.LyricsSongPayload, error) {
+		song, err := parseChordPro(r)
+		if err != nil {
+			return nil, err
+		}
+		if song.Title == "" {
+			song.Title = strings.TrimSuffix(path.Base(file), path.Ext(file))
+		}
+		return song, nil
+	})
+}
+
+// SongKey is the key the chords of a song are stored in: its own Key, or
+// else the key of its first chord. It is empty when the song has neither.
+func SongKey(song entities.LyricsSong) string {
+	if song.Key != "" {
+		return song.Key
+	}
+	for _, segment := range song.Segments {
+		for _, match := range inlineChord.FindAllStringSubmatch(segment.Chords, -1) {
+			if key := ChordKey(match[1]); key != "" {
+				return key
+			}
+		}
+	}
+	return ""
+}
+
+// chart is a song's chords as they are going to be written, with the
+// segments in the order they are sung.
+type chart struct {
+	key      string
+	capo     int
+	shift    int
+	flats    bool
+	segments []entities.LyricsSegment
+}
+
+func newChart(song entities.LyricsSong, options ChordOptions) (chart, error) {
+	target, err := NormalizeKey(options.Key)
+	if err != nil {
+		return chart{}, err
+	}
+	segments, _, err := Arrange(song, options.Arrangement)
+	if err != nil {
+		return chart{}, err
+	}
+	from := SongKey(song)
+	shift := options.Transpose
+	if target != "" && from != "" {
+		if isMinor(target) != isMinor(from) {
+			return chart{}, ErrKeyMode
+		}
+		shift = keyInterval(from, target)
+	}
+
+	c := chart{key: target, capo: options.Capo, shift: shift - options.Capo, segments: segments}
+	if from != "" {
+		c.key, _ = transposeKey(from, shift)
+		_, c.flats = transposeKey(from, c.shift)
+	}
+	return c, nil
+}
+
+// lines returns the lines of a segment with its chords moved to the chart.
+func (c chart) lines(segment entities.LyricsSegment) []string {
+	text := segment.Chords
+	if text == "" {
+		text = segment.Content
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = TransposeLine(line, c.shift, c.flats)
+	}
+	return lines
+}
+
+// WriteChordPro writes song as ChordPro, transposed and arranged as options
+// say. A chorus sung again is written as {chorus}.
+func WriteChordPro(w io.Writer, song entities.LyricsSong, options ChordOptions) error {
+	c, err := newChart(song, options)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "{title: %s}\n", song.Title)
+	for _, author := range song.Authors {
+		fmt.Fprintf(&b, "{artist: %s}\n", author)
+	}
+	if song.Copyright != "" {
+		fmt.Fprintf(&b, "{copyright: %s}\n", song.Copyright)
+	}
+	if song.CCLI != "" {
+		fmt.Fprintf(&b, "{meta: ccli %s}\n", song.CCLI)
+	}
+	if c.key != "" {
+		fmt.Fprintf(&b, "{key: %s}\n", c.key)
+	}
+	if c.capo > 0 {
+		fmt.Fprintf(&b, "{capo: %d}\n", c.capo)
+	}
+	if song.Tempo > 0 {
+		fmt.Fprintf(&b, "{tempo: %d}\n", song.Tempo)
+	}
+	if len(song.Tags) > 0 {
+		fmt.Fprintf(&b, "{meta: keywords %s}\n", strings.Join(song.Tags, ", "))
+	}
+
+	written := map[string]bool{}
+	for _, segment := range c.segments {
+		if strings.TrimSpace(segment.Content) == "" {
+			continue
+		}
+		b.WriteByte('\n')
+		key := segment.Kind + "\x00" + segment.Content
+		if segment.Kind == consts.SegmentChorus && written[key] {
+			fmt.Fprintf(&b, "{chorus: %s}\n", segment.Title)
+			continue
+		}
+		written[key] = true
+
+		environment, ok := chordProSections[segment.Kind]
+		if !ok {
+			environment = "verse"
+		}
+		fmt.Fprintf(&b, "{start_of_%s: %s}\n", environment, segment.Title)
+		for _, line := range c.lines(segment) {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "{end_of_%s}\n", environment)
+	}
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// WriteChordSheet writes song as plain text with the chords over the
+// lyrics, transposed and arranged as options say. A section sung again
+// shows only its title.
+func WriteChordSheet(w io.Writer, song entities.LyricsSong, options ChordOptions) error {
+	c, err := newChart(song, options)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	b.WriteString(song.Title + "\n")
+	if len(song.Authors) > 0 {
+		b.WriteString(strings.Join(song.Authors, ", ") + "\n")
+	}
+	var header []string
+	if c.key != "" {
+		header = append(header, "Tono: "+c.key)
+	}
+	if c.capo > 0 {
+		header = append(header, fmt.Sprintf("Capo: %d", c.capo))
+	}
+	if len(header) > 0 {
+		b.WriteString(strings.Join(header, "   ") + "\n")
+	}
+
+	written := map[string]bool{}
+	for _, segment := range c.segments {
+		if strings.TrimSpace(segment.Content) == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "\n[%s]\n", segment.Title)
+		key := segment.Kind + "\x00" + segment.Content
+		if written[key] {
+			continue
+		}
+		written[key] = true
+		for _, line := range c.lines(segment) {
+			chords, lyrics := chordOverLyrics(line)
+			if chords != "" {
+				b.WriteString(chords + "\n")
+			}
+			if lyrics != "" || chords == "" {
+				b.WriteString(lyrics + "\n")
+			}
+		}
+	}
+
+	if song.Copyright != "" || song.CCLI != "" {
+		b.WriteByte('\n')
+		if song.Copyright != "" {
+			b.WriteString("© " + strings.TrimPrefix(song.Copyright, "© ") + "\n")
+		}
+		if song.CCLI != "" {
+			b.WriteString("CCLI " + song.CCLI + "\n")
+		}
+	}
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// chordOverLyrics splits a ChordPro line into a row of chords, each one
+// above the letter it was written before, and the lyrics. The lyrics get
+// spaces where two chords would otherwise overlap.
+func chordOverLyrics(line string) (string, string) {
+	var chords, lyrics []rune
+	rest := line
+	for {
+		loc := inlineChord.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			lyrics = append(lyrics, []rune(rest)...)
+			break
+		}
+		chord := rest[loc[2]:loc[3]]
+		lyrics = append(lyrics, []rune(rest[:loc[0]])...)
+		rest = rest[loc[1]:]
+		if !IsChord(chord) {
+			lyrics = append(lyrics, []rune("["+chord+"]")...)
+			continue
+		}
+
+		if len(chords) > 0 && len(chords) >= len(lyrics) {
+			for len(lyrics) <= len(chords) {
+				lyrics = append(lyrics, ' ')
+			}
+		}
+		for len(chords) < len(lyrics) {
+			chords = append(chords, ' ')
+		}
+		chords = append(chords, []rune(chord)...)
+	}
+	return strings.TrimRight(string(chords), " "), strings.TrimRight(string(lyrics), " ")
+}
+
+// WriteChordProZip writes every song as its own ChordPro file of a zip.
+func WriteChordProZip(w io.Writer, songs []entities.LyricsSong) error {
+	return writeSongZip(w, songs, ".cho", func(file io.Writer, song entities.LyricsSong) error {
+		return WriteChordPro(file, song, ChordOptions{})
+	})
+}
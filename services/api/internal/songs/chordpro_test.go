@@ -0,0 +1,150 @@
+package songs_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/songs"
+	"strings"
+	"testing"
+)
+
+const sublimeGracia = `{title: Sublime gracia}
+{artist: John Newton}
+{key: G}
+{tempo: 72}
+{meta: ccli 22025}
+
+{start_of_verse: Verso 1}
+[G]Sublime [C]gracia del [G]Señor
+que a un [D]pecador salvó
+{end_of_verse}
+
+{start_of_chorus}
+[G]Gloria a [D]Dios
+{end_of_chorus}
+
+{start_of_verse: Verso 2}
+[G]Su gracia me enseñó a temer
+{end_of_verse}
+
+{chorus}
+`
+
+func TestParseChordPro(t *testing.T) {
+	t.Run("should read the sections, the chords and the order they are sung in", func(t *testing.T) {
+		song, err := songs.ParseChordPro(strings.NewReader(sublimeGracia))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Sublime gracia", song.Title)
+		assert.Equal(t, []string{"John Newton"}, song.Authors)
+		assert.Equal(t, "G", song.Key)
+		assert.Equal(t, 72, song.Tempo)
+		assert.Equal(t, "22025", song.CCLI)
+		require.Len(t, song.Segments, 3)
+		assert.Equal(t, "Sublime gracia del Señor\nque a un pecador salvó", song.Segments[0].Content)
+		assert.Equal(t, "[G]Sublime [C]gracia del [G]Señor\nque a un [D]pecador salvó", song.Segments[0].Chords)
+		require.Len(t, song.Arrangements, 1)
+		assert.Equal(t, []string{"v1", "c1", "v2", "c1"}, song.Arrangements[0].Sequence)
+	})
+
+	t.Run("should store a song written for a capo in the key it sounds in", func(t *testing.T) {
+		song, err := songs.ParseChordPro(strings.NewReader("{title: Santo}\n{key: G}\n{capo: 2}\n[G]Santo, [C]santo, [D]santo\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "A", song.Key)
+		assert.Equal(t, "[A]Santo, [D]santo, [E]santo", song.Segments[0].Chords)
+	})
+
+	t.Run("should fail on a song without a title", func(t *testing.T) {
+		_, err := songs.ParseChordPro(strings.NewReader("[G]Santo, santo, santo\n"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWriteChordPro(t *testing.T) {
+	t.Run("should write a song that reads back the same", func(t *testing.T) {
+		song := parsedSong(t, sublimeGracia)
+		var out bytes.Buffer
+
+		require.NoError(t, songs.WriteChordPro(&out, song, songs.ChordOptions{}))
+
+		assert.Contains(t, out.String(), "{chorus: Coro}\n")
+		again, err := songs.ParseChordPro(&out)
+		require.NoError(t, err)
+		assert.Equal(t, song.Key, again.Key)
+		assert.Equal(t, song.CCLI, again.CCLI)
+		assert.Equal(t, song.Segments, again.Segments)
+		assert.Equal(t, song.Arrangements[0].Sequence, again.Arrangements[0].Sequence)
+	})
+
+	t.Run("should transpose to a key and write the shapes played with a capo", func(t *testing.T) {
+		var out bytes.Buffer
+
+		require.NoError(t, songs.WriteChordPro(&out, parsedSong(t, sublimeGracia), songs.ChordOptions{Key: "Bb", Capo: 1}))
+
+		assert.Contains(t, out.String(), "{key: Bb}\n{capo: 1}\n")
+		assert.Contains(t, out.String(), "[A]Sublime [D]gracia del [A]Señor\nque a un [E]pecador salvó\n")
+	})
+
+	t.Run("should spell the chords with flats in a flat key", func(t *testing.T) {
+		var out bytes.Buffer
+
+		require.NoError(t, songs.WriteChordPro(&out, parsedSong(t, sublimeGracia), songs.ChordOptions{Transpose: 3}))
+
+		assert.Contains(t, out.String(), "{key: Bb}\n")
+		assert.Contains(t, out.String(), "que a un [F]pecador salvó\n")
+		assert.Contains(t, out.String(), "[Bb]Sublime [Eb]gracia")
+	})
+
+	t.Run("should keep the Latin names of the chords", func(t *testing.T) {
+		var out bytes.Buffer
+		song := parsedSong(t, "{title: Sublime gracia}\n[Do]Sublime [Fa]gracia del [Do]Señor\nque a un [Sol7]pecador salvó\n")
+
+		require.NoError(t, songs.WriteChordPro(&out, song, songs.ChordOptions{Key: "Re"}))
+
+		assert.Contains(t, out.String(), "{key: Re}\n")
+		assert.Contains(t, out.String(), "[Re]Sublime [Sol]gracia del [Re]Señor\nque a un [La7]pecador salvó\n")
+	})
+
+	t.Run("should reject a key of the other mode", func(t *testing.T) {
+		var out bytes.Buffer
+
+		err := songs.WriteChordPro(&out, parsedSong(t, sublimeGracia), songs.ChordOptions{Key: "Am"})
+
+		assert.ErrorIs(t, err, songs.ErrKeyMode)
+	})
+}
+
+func TestWriteChordSheet(t *testing.T) {
+	t.Run("should write the chords over the letters they were before", func(t *testing.T) {
+		var out bytes.Buffer
+
+		require.NoError(t, songs.WriteChordSheet(&out, parsedSong(t, sublimeGracia), songs.ChordOptions{Capo: 2}))
+
+		assert.Contains(t, out.String(), "Tono: G   Capo: 2\n")
+		assert.Contains(t, out.String(), "F       Bb         F\nSublime gracia del Señor\n")
+		assert.Contains(t, out.String(), "\n[Coro]\n\nCCLI 22025\n")
+	})
+}
+
+// parsedSong reads a ChordPro song the way the library stores it.
+func parsedSong(t *testing.T, text string) entities.LyricsSong {
+	t.Helper()
+	payload, err := songs.ParseChordPro(strings.NewReader(text))
+	require.NoError(t, err)
+	return entities.LyricsSong{
+		Title:              payload.Title,
+		Lyrics:             payload.Lyrics,
+		Segments:           payload.Segments,
+		Arrangements:       payload.Arrangements,
+		DefaultArrangement: payload.DefaultArrangement,
+		Authors:            payload.Authors,
+		CCLI:               payload.CCLI,
+		Key:                payload.Key,
+		Tempo:              payload.Tempo,
+		Tags:               payload.Tags,
+	}
+}
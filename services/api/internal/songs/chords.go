@@ -0,0 +1,381 @@
+package songs
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidKey is returned for a key that is not a note, such as "H".
+	ErrInvalidKey = errors.New("invalid key")
+	// ErrKeyMode is returned when a song is asked for in a minor key and it
+	// is in a major one, or the other way round.
+	ErrKeyMode = errors.New("key is not in the mode of the song")
+)
+
+var (
+	// chordName matches a chord: a root, its quality and an optional bass,
+	// as in "G", "F#m7", "Bbsus4" or "C/E". Roots may also be written with
+	// the Latin names, as in "Lam" or "Sol/Si". Words such as "Coro" or
+	// "x2" do not match, so they are never taken for chords.
+	chordName = regexp.MustCompile(`^([A-G]|Do|Re|Mi|Fa|Sol|La|Si)([#b]?)((?:maj|min|dim|aug|sus|add|alt|m|M|[0-9]|[#b+()°ø-])*)(?:/([A-G]|Do|Re|Mi|Fa|Sol|La|Si)([#b]?))?$`)
+	// inlineChord matches a bracketed chord of a ChordPro line.
+	inlineChord = regexp.MustCompile(`\[([^\[\]\s]{1,16})\]`)
+)
+
+var (
+	sharpNotes      = [12]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+	flatNotes       = [12]string{"C", "Db", "D", "Eb", "E", "F", "Gb", "G", "Ab", "A", "Bb", "B"}
+	latinSharpNotes = [12]string{"Do", "Do#", "Re", "Re#", "Mi", "Fa", "Fa#", "Sol", "Sol#", "La", "La#", "Si"}
+	latinFlatNotes  = [12]string{"Do", "Reb", "Re", "Mib", "Mi", "Fa", "Solb", "Sol", "Lab", "La", "Sib", "Si"}
+	// flatKeys are the keys whose chords are written with flats.
+	flatKeys = map[string]bool{
+		"F": true, "Bb": true, "Eb": true, "Ab": true, "Db": true, "Gb": true,
+		"Dm": true, "Gm": true, "Cm": true, "Fm": true, "Bbm": true, "Ebm": true,
+	}
+	naturalNotes = map[string]int{
+		"C": 0, "D": 2, "E": 4, "F": 5, "G": 7, "A": 9, "B": 11,
+		"Do": 0, "Re": 2, "Mi": 4, "Fa": 5, "Sol": 7, "La": 9, "Si": 11,
+	}
+)
+
+// noChord is written where the band stops playing.
+const noChord = "N.C."
+
+// IsChord tells whether name is a chord ChordPro would write in brackets.
+func IsChord(name string) bool {
+	return name == noChord || chordName.MatchString(name)
+}
+
+// StripChords removes the [chords] of a ChordPro text and keeps the lyrics.
+// Brackets that do not hold a chord, like "[x2]", stay, and lines that only
+// had chords are dropped.
+func StripChords(text string) string {
+	lines := strings.Split(text, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		stripped := inlineChord.ReplaceAllStringFunc(line, func(match string) string {
+			if IsChord(match[1 : len(match)-1]) {
+				return ""
+			}
+			return match
+		})
+		if stripped == line {
+			kept = append(kept, strings.TrimSpace(line))
+			continue
+		}
+		if stripped = collapse(stripped); stripped != "" {
+			kept = append(kept, stripped)
+		}
+	}
+	return joinLines(kept)
+}
+
+// AlignChords moves the chords of a ChordPro text onto lyrics edited after
+// the chords were written. The lines are paired in order: a line that did
+// not change keeps its chords, a changed one gets them over the same words
+// and the chords of a removed line are dropped. Lines of chords alone stay
+// before the line they were before.
+func AlignChords(chords string, lyrics string) string {
+	var old []chordLine
+	var before []string
+	for _, line := range strings.Split(chords, "\n") {
+		text, placed := splitChordLine(line)
+		if text == "" && len(placed) > 0 {
+			before = append(before, strings.TrimSpace(line))
+			continue
+		}
+		old = append(old, chordLine{line: strings.TrimSpace(line), text: text, chords: placed, before: before})
+		before = nil
+	}
+	lines := strings.Split(lyrics, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+
+	partners := pairLines(old, lines)
+	leading := make([][]string, len(lines))
+	next := 0
+	for j, i := range partners {
+		// Chords alone before a removed line go before the next line kept.
+		for ; i >= 0 && next <= i; next++ {
+			leading[j] = append(leading[j], old[next].before...)
+		}
+	}
+	var trailing []string
+	for ; next < len(old); next++ {
+		trailing = append(trailing, old[next].before...)
+	}
+	trailing = append(trailing, before...)
+
+	aligned := make([]string, 0, len(lines)+len(trailing))
+	for j, line := range lines {
+		aligned = append(aligned, leading[j]...)
+		switch i := partners[j]; {
+		case i < 0:
+			aligned = append(aligned, line)
+		case old[i].text == line:
+			aligned = append(aligned, old[i].line)
+		default:
+			aligned = append(aligned, placeChords(line, old[i].chords))
+		}
+	}
+	return joinLines(append(aligned, trailing...))
+}
+
+// chordLine is a line of a ChordPro text read apart: its lyrics, the
+// chords over them and the lines of chords alone written before it.
+type chordLine struct {
+	line   string
+	text   string
+	chords []placedChord
+	before []string
+}
+
+// placedChord is a chord over the offset-th letter of the word-th word of
+// a line. A chord after the last word has word set to the number of words.
+type placedChord struct {
+	chord  string
+	word   int
+	offset int
+}
+
+// splitChordLine reads the lyrics of a ChordPro line, as StripChords does,
+// and where its chords are over them.
+func splitChordLine(line string) (string, []placedChord) {
+	var text strings.Builder
+	var placed []placedChord
+	last := 0
+	for _, match := range inlineChord.FindAllStringSubmatchIndex(line, -1) {
+		chord := line[match[2]:match[3]]
+		if !IsChord(chord) {
+			continue
+		}
+		text.WriteString(line[last:match[0]])
+		last = match[1]
+		prefix := text.String()
+		words := strings.Fields(prefix)
+		at := placedChord{chord: chord, word: len(words)}
+		if len(words) > 0 && !unicode.IsSpace(lastRune(prefix)) {
+			at.word, at.offset = len(words)-1, utf8.RuneCountInString(words[len(words)-1])
+		}
+		placed = append(placed, at)
+	}
+	if placed == nil {
+		return strings.TrimSpace(line), nil
+	}
+	text.WriteString(line[last:])
+	return collapse(text.String()), placed
+}
+
+// placeChords writes chords into line over the words they were over.
+func placeChords(line string, chords []placedChord) string {
+	var starts []int
+	for i, r := range line {
+		if !unicode.IsSpace(r) && (i == 0 || unicode.IsSpace(lastRune(line[:i]))) {
+			starts = append(starts, i)
+		}
+	}
+	var out strings.Builder
+	last := 0
+	for _, placed := range chords {
+		at := len(line)
+		if placed.word < len(starts) {
+			at = starts[placed.word]
+			for n := 0; n < placed.offset && at < len(line); n++ {
+				r, size := utf8.DecodeRuneInString(line[at:])
+				if unicode.IsSpace(r) {
+					break
+				}
+				at += size
+			}
+		}
+		out.WriteString(line[last:at])
+		out.WriteString("[" + placed.chord + "]")
+		last = at
+	}
+	out.WriteString(line[last:])
+	return out.String()
+}
+
+// pairLines pairs every new line with the old line it was, or -1. Lines
+// left as they were are paired first; the others between them are paired
+// in order.
+func pairLines(old []chordLine, lines []string) []int {
+	common := make([][]int, len(old)+1)
+	for i := range common {
+		common[i] = make([]int, len(lines)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(lines) - 1; j >= 0; j-- {
+			switch {
+			case old[i].text == lines[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	partners := make([]int, len(lines))
+	var changedOld, changedNew []int
+	pairChanged := func() {
+		for k := 0; k < len(changedOld) && k < len(changedNew); k++ {
+			partners[changedNew[k]] = changedOld[k]
+		}
+		changedOld, changedNew = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(old) || j < len(lines) {
+		switch {
+		case i < len(old) && j < len(lines) && old[i].text == lines[j]:
+			pairChanged()
+			partners[j] = i
+			i, j = i+1, j+1
+		case j == len(lines) || (i < len(old) && common[i+1][j] >= common[i][j+1]):
+			changedOld = append(changedOld, i)
+			i++
+		default:
+			partners[j] = -1
+			changedNew = append(changedNew, j)
+			j++
+		}
+	}
+	pairChanged()
+	return partners
+}
+
+func lastRune(text string) rune {
+	r, _ := utf8.DecodeLastRuneInString(text)
+	return r
+}
+
+// HasChords tells whether a ChordPro text holds at least one chord.
+func HasChords(text string) bool {
+	for _, match := range inlineChord.FindAllStringSubmatch(text, -1) {
+		if IsChord(match[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// TransposeChord moves a chord by semitones, spelling the new notes with
+// flats when flats is set, and with the Latin names when the chord uses
+// them. Anything that is not a chord comes back as is.
+func TransposeChord(chord string, semitones int, flats bool) string {
+	parts := chordName.FindStringSubmatch(chord)
+	if parts == nil || semitones%12 == 0 {
+		return chord
+	}
+	latin := isLatin(parts[1])
+	transposed := noteName(noteIndex(parts[1], parts[2])+semitones, flats, latin) + parts[3]
+	if parts[4] != "" {
+		transposed += "/" + noteName(noteIndex(parts[4], parts[5])+semitones, flats, latin)
+	}
+	return transposed
+}
+
+// TransposeLine moves every bracketed chord of a ChordPro line.
+func TransposeLine(line string, semitones int, flats bool) string {
+	if semitones%12 == 0 {
+		return line
+	}
+	return inlineChord.ReplaceAllStringFunc(line, func(match string) string {
+		return "[" + TransposeChord(match[1:len(match)-1], semitones, flats) + "]"
+	})
+}
+
+// NormalizeKey reads a key such as "G", "bb", "F#m" or "lam" and writes
+// it the way chords are written: "G", "Bb", "F#m", "Lam".
+func NormalizeKey(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", nil
+	}
+	key = strings.ToUpper(key[:1]) + key[1:]
+	if strings.HasSuffix(key, "min") {
+		key = strings.TrimSuffix(key, "in")
+	}
+	parts := chordName.FindStringSubmatch(key)
+	if parts == nil || parts[4] != "" || (parts[3] != "" && parts[3] != "m") {
+		return "", ErrInvalidKey
+	}
+	return parts[1] + parts[2] + parts[3], nil
+}
+
+// ChordKey returns the key a chord points at: "Am7" is in "Am", "G/B" in
+// "G". It is empty for anything that is not a chord.
+func ChordKey(chord string) string {
+	parts := chordName.FindStringSubmatch(chord)
+	if parts == nil {
+		return ""
+	}
+	key := parts[1] + parts[2]
+	if strings.HasPrefix(parts[3], "m") && !strings.HasPrefix(parts[3], "maj") {
+		key += "m"
+	}
+	return key
+}
+
+// keyInterval counts the semitones from one key up to another, ignoring
+// whether they are major or minor.
+func keyInterval(from string, to string) int {
+	fromParts := chordName.FindStringSubmatch(from)
+	toParts := chordName.FindStringSubmatch(to)
+	if fromParts == nil || toParts == nil {
+		return 0
+	}
+	return ((noteIndex(toParts[1], toParts[2])-noteIndex(fromParts[1], fromParts[2]))%12 + 12) % 12
+}
+
+// transposeKey moves a key by semitones and spells it the usual way, with
+// flats for keys such as "Bb" or "Gm", in the naming the key was written
+// in. It tells whether the chords of the new key are written with flats.
+func transposeKey(key string, semitones int) (string, bool) {
+	parts := chordName.FindStringSubmatch(key)
+	if parts == nil {
+		return key, false
+	}
+	index := noteIndex(parts[1], parts[2]) + semitones
+	flats := flatKeys[noteName(index, true, false)+parts[3]]
+	return noteName(index, flats, isLatin(parts[1])) + parts[3], flats
+}
+
+// isMinor tells whether a key written as NormalizeKey does is minor.
+func isMinor(key string) bool {
+	return strings.HasSuffix(key, "m")
+}
+
+func isLatin(root string) bool {
+	return len(root) > 1
+}
+
+func noteIndex(root string, accidental string) int {
+	index := naturalNotes[root]
+	switch accidental {
+	case "#":
+		index++
+	case "b":
+		index--
+	}
+	return (index + 12) % 12
+}
+
+func noteName(index int, flats bool, latin bool) string {
+	index = (index%12 + 12) % 12
+	switch {
+	case latin && flats:
+		return latinFlatNotes[index]
+	case latin:
+		return latinSharpNotes[index]
+	case flats:
+		return flatNotes[index]
+	}
+	return sharpNotes[index]
+}
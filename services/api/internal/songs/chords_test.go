@@ -0,0 +1,104 @@
+package songs_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/songs"
+	"testing"
+)
+
+func TestStripChords(t *testing.T) {
+	t.Run("should drop the chords and the lines of chords alone", func(t *testing.T) {
+		text := songs.StripChords("[G] [D]\n[G]Sublime [C]gracia del [G]Señor\n[Coro] que a un [D]pecador salvó")
+
+		assert.Equal(t, "Sublime gracia del Señor\n[Coro] que a un pecador salvó", text)
+	})
+
+	t.Run("should read chords written with the Latin names", func(t *testing.T) {
+		assert.Equal(t, "Sublime gracia", songs.StripChords("[Do]Sublime [Sol]gracia"))
+		assert.Equal(t, "del Señor", songs.StripChords("[Lam]del [Fa#m7]Se[Sib/Re]ñor"))
+	})
+}
+
+func TestTransposeChord(t *testing.T) {
+	tests := []struct {
+		chord     string
+		semitones int
+		flats     bool
+		expected  string
+	}{
+		{chord: "G", semitones: 2, expected: "A"},
+		{chord: "F#m7", semitones: 1, expected: "Gm7"},
+		{chord: "C/E", semitones: 3, flats: true, expected: "Eb/G"},
+		{chord: "Bbsus4", semitones: -1, expected: "Asus4"},
+		{chord: "Lam", semitones: 2, expected: "Sim"},
+		{chord: "Sol/Si", semitones: 1, flats: true, expected: "Lab/Do"},
+		{chord: "Do7", semitones: 1, expected: "Do#7"},
+		{chord: "N.C.", semitones: 2, expected: "N.C."},
+		{chord: "Coro", semitones: 2, expected: "Coro"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chord, func(t *testing.T) {
+			assert.Equal(t, tt.expected, songs.TransposeChord(tt.chord, tt.semitones, tt.flats))
+		})
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "g", expected: "G"},
+		{key: "bb", expected: "Bb"},
+		{key: "F#min", expected: "F#m"},
+		{key: "lam", expected: "Lam"},
+		{key: "sol", expected: "Sol"},
+		{key: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			key, err := songs.NormalizeKey(tt.key)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+
+	t.Run("should reject what is not a key", func(t *testing.T) {
+		for _, key := range []string{"H", "G7", "C/E", "Coro"} {
+			_, err := songs.NormalizeKey(key)
+
+			assert.ErrorIs(t, err, songs.ErrInvalidKey, key)
+		}
+	})
+}
+
+func TestAlignChords(t *testing.T) {
+	chords := "[G] [D]\n[G]Sublime [C]gracia del [G]Señor\nque a un [D]peca[Em]dor salvó"
+
+	t.Run("should keep the chords of the lines that did not change", func(t *testing.T) {
+		aligned := songs.AlignChords(chords, "Sublime gracia del Señor\nque a un pecador salvó\nfui ciego mas hoy veo yo")
+
+		assert.Equal(t, "[G] [D]\n[G]Sublime [C]gracia del [G]Señor\nque a un [D]peca[Em]dor salvó\nfui ciego mas hoy veo yo", aligned)
+	})
+
+	t.Run("should keep the chords of a changed line over the same words", func(t *testing.T) {
+		aligned := songs.AlignChords(chords, "Sublime gracia del Señor\nque a mí, pecador, salvó")
+
+		assert.Equal(t, "[G] [D]\n[G]Sublime [C]gracia del [G]Señor\nque a mí, [D]peca[Em]dor, salvó", aligned)
+	})
+
+	t.Run("should put the chords after the last word of a shorter line at its end", func(t *testing.T) {
+		aligned := songs.AlignChords(chords, "Sublime gracia\nque a un pecador salvó")
+
+		assert.Equal(t, "[G] [D]\n[G]Sublime [C]gracia[G]\nque a un [D]peca[Em]dor salvó", aligned)
+	})
+
+	t.Run("should drop the chords of a removed line and keep the chords alone before the next one", func(t *testing.T) {
+		aligned := songs.AlignChords(chords, "que a un pecador salvó")
+
+		assert.Equal(t, "[G] [D]\nque a un [D]peca[Em]dor salvó", aligned)
+		assert.Equal(t, "que a un pecador salvó", songs.StripChords(aligned))
+	})
+}
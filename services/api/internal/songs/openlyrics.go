@@ -0,0 +1,494 @@
+package songs
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+)
+
+// openLyricsNamespace and openLyricsVersion are written on every export.
+const (
+	openLyricsNamespace = "http://openlyrics.info/namespace/2009/song"
+	openLyricsVersion   = "0.9"
+	openLyricsCreator   = "ionicX"
+)
+
+// verseTypes maps the first letter of an OpenLyrics verse name ("v1",
+// "c", "b2") onto the title and kind of the segment.
+var verseTypes = map[byte]struct {
+	title string
+	kind  string
+}{
+	'v': {"Verso", consts.SegmentVerse},
+	'c': {"Coro", consts.SegmentChorus},
+	'p': {"Pre-coro", consts.SegmentChorus},
+	'b': {"Puente", consts.SegmentBridge},
+	'i': {"Intro", consts.SegmentIntro},
+	'e': {"Final", consts.SegmentOutro},
+	'o': {"Otro", consts.SegmentVerse},
+}
+
+// verseLetters is the reverse of verseTypes, used on export.
+var verseLetters = map[string]string{
+	consts.SegmentVerse:  "v",
+	consts.SegmentChorus: "c",
+	consts.SegmentBridge: "b",
+	consts.SegmentIntro:  "i",
+	consts.SegmentOutro:  "e",
+}
+
+type openLyricsVerse struct {
+	name    string
+	content string
+	chords  string
+}
+
+var (
+	spaceRun = regexp.MustCompile(`\s+`)
+	// verseName matches the names kept on export, like the "v1" or "c" of
+	// an imported song.
+	verseName = regexp.MustCompile(`^[vcpbieo][0-9]*[a-z]?$`)
+)
+
+// ParseOpenLyrics reads one OpenLyrics song (0.8 or 0.9). Every verse
+// becomes a segment named after it, and a verse order that repeats or
+// moves them becomes the default arrangement. Chords are kept
+// in ChordPro notation, comments are dropped, and when the song has several
+// languages only the first one of every verse is kept.
+func ParseOpenLyrics(r io.Reader) (*entities.LyricsSongPayload, error) {
+	decoder := xml.NewDecoder(r)
+
+	var (
+		song       entities.LyricsSongPayload
+		verseOrder string
+		verses     []openLyricsVerse
+		seen       = map[string]bool{}
+		current    *openLyricsVerse
+		lines      *strings.Builder
+		field      *strings.Builder
+		skipDepth  int
+		isSong     bool
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid openlyrics xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			switch t.Name.Local {
+			case "song":
+				isSong = true
+			case "title", "author", "copyright", "ccliNo", "verseOrder", "key", "theme":
+				if current == nil {
+					field = &strings.Builder{}
+				}
+			case "tempo":
+				if current == nil && attr(t, "type") != "text" {
+					field = &strings.Builder{}
+				}
+			case "verse":
+				verseName := strings.ToLower(strings.TrimSpace(attr(t, "name")))
+				if verseName == "" || seen[verseName] {
+					skipDepth = 1
+					continue
+				}
+				seen[verseName] = true
+				current = &openLyricsVerse{name: verseName}
+			case "lines":
+				if current != nil {
+					lines = &strings.Builder{}
+				}
+			case "br":
+				if lines != nil {
+					lines.WriteByte('\n')
+				}
+			case "chord":
+				if lines != nil {
+					if chord := openLyricsChord(t); chord != "" {
+						lines.WriteString("[" + chord + "]")
+					}
+				}
+			case "comment":
+				skipDepth = 1
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			switch t.Name.Local {
+			case "title":
+				if field != nil && song.Title == "" {
+					song.Title = collapse(field.String())
+				}
+				field = nil
+			case "author":
+				if field != nil {
+					if author := collapse(field.String()); author != "" {
+						song.Authors = append(song.Authors, author)
+					}
+				}
+				field = nil
+			case "copyright":
+				if field != nil {
+					song.Copyright = collapse(field.String())
+				}
+				field = nil
+			case "ccliNo":
+				if field != nil {
+					song.CCLI = collapse(field.String())
+				}
+				field = nil
+			case "verseOrder":
+				if field != nil {
+					verseOrder = collapse(field.String())
+				}
+				field = nil
+			case "key":
+				if field != nil {
+					if key, err := NormalizeKey(field.String()); err == nil {
+						song.Key = key
+					}
+				}
+				field = nil
+			case "tempo":
+				if field != nil {
+					if tempo, err := strconv.Atoi(collapse(field.String())); err == nil && tempo > 0 {
+						song.Tempo = tempo
+					}
+				}
+				field = nil
+			case "theme":
+				if field != nil {
+					if theme := collapse(field.String()); theme != "" {
+						song.Tags = append(song.Tags, theme)
+					}
+				}
+				field = nil
+			case "line":
+				if lines != nil {
+					lines.WriteByte('\n')
+				}
+			case "lines":
+				if lines != nil && current != nil {
+					if current.content != "" {
+						current.content += "\n"
+					}
+					current.content += joinLines(strings.Split(lines.String(), "\n"))
+				}
+				lines = nil
+			case "verse":
+				if current != nil && HasChords(current.content) {
+					current.chords = current.content
+					current.content = StripChords(current.content)
+				}
+				if current != nil && current.content != "" {
+					verses = append(verses, *current)
+				}
+				current = nil
+			}
+		case xml.CharData:
+			if skipDepth > 0 {
+				continue
+			}
+			switch {
+			case field != nil:
+				field.Write(t)
+			case lines != nil:
+				lines.WriteString(spaceRun.ReplaceAllString(string(t), " "))
+			}
+		}
+	}
+
+	if !isSong {
+		return nil, errors.New("not an openlyrics song")
+	}
+	if song.Title == "" {
+		return nil, errors.New("song has no title")
+	}
+	if len(verses) == 0 {
+		return nil, errors.New("song has no lyrics")
+	}
+
+	texts := make([]string, 0, len(verses))
+	for _, verse := range verses {
+		texts = append(texts, verse.content)
+	}
+	song.Lyrics = strings.Join(texts, "\n\n")
+
+	for _, verse := range verses {
+		title, kind := verseTitle(verse.name)
+		segment := newSegment(verse.name, title, kind, verse.content)
+		segment.Chords = verse.chords
+		song.Segments = append(song.Segments, segment)
+	}
+	ordered := orderVerses(verses, verseOrder)
+	sequence := make([]string, 0, len(ordered))
+	for _, verse := range ordered {
+		sequence = append(sequence, verse.name)
+	}
+	setImportedArrangement(&song, sequence)
+	return &song, nil
+}
+
+// openLyricsChord reads the chord of a <chord> element: its name in 0.8,
+// or its root and bass in 0.9, with the structure when it reads as a chord.
+func openLyricsChord(element xml.StartElement) string {
+	if name := attr(element, "name"); IsChord(name) {
+		return name
+	}
+	root := attr(element, "root")
+	if root == "" {
+		return ""
+	}
+	chord := root
+	if structure := attr(element, "structure"); IsChord(root + structure) {
+		chord = root + structure
+	}
+	if bass := attr(element, "bass"); bass != "" {
+		chord += "/" + bass
+	}
+	if !IsChord(chord) {
+		return ""
+	}
+	return chord
+}
+
+// orderVerses expands a verse order such as "v1 c v2 c". A name may stand
+// for its parts, so "v1" plays "v1a v1b". Unknown names are ignored.
+func orderVerses(verses []openLyricsVerse, order string) []openLyricsVerse {
+	byName := make(map[string]openLyricsVerse, len(verses))
+	for _, verse := range verses {
+		byName[verse.name] = verse
+	}
+
+	var ordered []openLyricsVerse
+	for _, name := range strings.Fields(strings.ToLower(order)) {
+		if verse, ok := byName[name]; ok {
+			ordered = append(ordered, verse)
+			continue
+		}
+		for _, verse := range verses {
+			suffix := strings.TrimPrefix(verse.name, name)
+			if suffix != verse.name && len(suffix) == 1 && suffix[0] >= 'a' && suffix[0] <= 'z' {
+				ordered = append(ordered, verse)
+			}
+		}
+	}
+	if len(ordered) == 0 {
+		return verses
+	}
+	return ordered
+}
+
+// verseTitle turns "v1" into "Verso 1" and "c" into "Coro", with their kind.
+func verseTitle(name string) (string, string) {
+	verseType, ok := verseTypes[name[0]]
+	if !ok {
+		return name, consts.SegmentVerse
+	}
+	if number := name[1:]; number != "" {
+		return verseType.title + " " + number, verseType.kind
+	}
+	return verseType.title, verseType.kind
+}
+
+// ReadOpenLyrics reads an uploaded OpenLyrics file, which may be a single
+// song or a zip of songs. Every song of a zip is reported on its own, so a
+// broken file does not stop the others.
+func ReadOpenLyrics(name string, content []byte) ([]entities.LyricsImportFile, error) {
+	return readSongFiles(name, content, "openlyrics", func(file string) bool {
+		return strings.EqualFold(path.Ext(file), ".xml")
+	}, func(_ string, r io.Reader) (*entities.LyricsSongPayload, error) {
+		return ParseOpenLyrics(r)
+	})
+}
+
+type openLyricsSong struct {
+	XMLName      xml.Name             `xml:"song"`
+	Namespace    string               `xml:"xmlns,attr"`
+	Version      string               `xml:"version,attr"`
+	CreatedIn    string               `xml:"createdIn,attr"`
+	ModifiedIn   string               `xml:"modifiedIn,attr"`
+	ModifiedDate string               `xml:"modifiedDate,attr,omitempty"`
+	Properties   openLyricsProperties `xml:"properties"`
+	Verses       []openLyricsXMLVerse `xml:"lyrics>verse"`
+}
+
+type openLyricsProperties struct {
+	Titles     []string         `xml:"titles>title"`
+	Authors    []string         `xml:"authors>author"`
+	Copyright  string           `xml:"copyright,omitempty"`
+	CCLI       string           `xml:"ccliNo,omitempty"`
+	Key        string           `xml:"key,omitempty"`
+	Tempo      *openLyricsTempo `xml:"tempo,omitempty"`
+	VerseOrder string           `xml:"verseOrder,omitempty"`
+	Themes     []string         `xml:"themes>theme,omitempty"`
+}
+
+type openLyricsTempo struct {
+	Type  string `xml:"type,attr"`
+	Value int    `xml:",chardata"`
+}
+
+type openLyricsXMLVerse struct {
+	Name  string          `xml:"name,attr"`
+	Lines openLyricsLines `xml:"lines"`
+}
+
+type openLyricsLines struct {
+	Inner string `xml:",innerxml"`
+}
+
+// WriteOpenLyrics writes song as OpenLyrics 0.9, with the verse order of
+// its default arrangement. Segments with the same kind and text become one
+// verse that the verse order repeats.
+func WriteOpenLyrics(w io.Writer, song entities.LyricsSong) error {
+	doc := openLyricsSong{
+		Namespace:    openLyricsNamespace,
+		Version:      openLyricsVersion,
+		CreatedIn:    openLyricsCreator,
+		ModifiedIn:   openLyricsCreator,
+		ModifiedDate: song.UpdatedAt,
+		Properties: openLyricsProperties{
+			Titles:    []string{song.Title},
+			Authors:   song.Authors,
+			Copyright: song.Copyright,
+			CCLI:      song.CCLI,
+			Key:       song.Key,
+			Themes:    song.Tags,
+		},
+	}
+	if song.Tempo > 0 {
+		doc.Properties.Tempo = &openLyricsTempo{Type: "bpm", Value: song.Tempo}
+	}
+
+	names := map[string]string{}
+	taken := map[string]bool{}
+	counts := map[string]int{}
+	verseNames := map[string]string{}
+	for _, segment := range song.Segments {
+		content := strings.TrimSpace(segment.Content)
+		if content == "" {
+			continue
+		}
+		key := segment.Kind + "\x00" + content
+		name, ok := names[key]
+		if !ok {
+			name, _, _ = strings.Cut(segment.ID, "-")
+			if !verseName.MatchString(name) || taken[name] {
+				letter, known := verseLetters[segment.Kind]
+				if !known {
+					letter = "v"
+				}
+				for name == "" || taken[name] || !verseName.MatchString(name) {
+					counts[letter]++
+					name = fmt.Sprintf("%s%d", letter, counts[letter])
+				}
+			}
+			names[key] = name
+			taken[name] = true
+			text := segment.Chords
+			if text == "" {
+				text = content
+			}
+			doc.Verses = append(doc.Verses, openLyricsXMLVerse{Name: name, Lines: openLyricsLines{Inner: linesXML(text)}})
+		}
+		if _, ok := verseNames[segment.ID]; !ok {
+			verseNames[segment.ID] = name
+		}
+	}
+	slides, _, _ := Arrange(song, "")
+	order := make([]string, 0, len(slides))
+	for _, slide := range slides {
+		if name, ok := verseNames[slide.ID]; ok {
+			order = append(order, name)
+		}
+	}
+	doc.Properties.VerseOrder = strings.Join(order, " ")
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// linesXML escapes every line and joins them with <br/>. The [chords] of
+// a ChordPro text become <chord/> elements.
+func linesXML(text string) string {
+	lines := strings.Split(text, "\n")
+	var b bytes.Buffer
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("<br/>")
+		}
+		line = strings.TrimSpace(line)
+		last := 0
+		for _, loc := range inlineChord.FindAllStringSubmatchIndex(line, -1) {
+			chord := line[loc[2]:loc[3]]
+			if !IsChord(chord) {
+				continue
+			}
+			_ = xml.EscapeText(&b, []byte(line[last:loc[0]]))
+			b.WriteString(`<chord name="`)
+			_ = xml.EscapeText(&b, []byte(chord))
+			b.WriteString(`"/>`)
+			last = loc[1]
+		}
+		_ = xml.EscapeText(&b, []byte(line[last:]))
+	}
+	return b.String()
+}
+
+// WriteOpenLyricsZip writes every song as its own file of a zip.
+func WriteOpenLyricsZip(w io.Writer, songs []entities.LyricsSong) error {
+	return writeSongZip(w, songs, ".xml", WriteOpenLyrics)
+}
+
+var unsafeFileChars = strings.NewReplacer(`/`, " ", `\`, " ", ":", " ", "*", " ", "?", " ", `"`, " ", "<", " ", ">", " ", "|", " ")
+
+// FileName turns a song title into a file name with ext.
+func FileName(title string, ext string) string {
+	name := collapse(unsafeFileChars.Replace(title))
+	if name == "" {
+		name = "cancion"
+	}
+	return name + ext
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func collapse(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
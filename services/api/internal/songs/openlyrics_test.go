@@ -0,0 +1,67 @@
+package songs_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/internal/songs"
+	"strings"
+	"testing"
+)
+
+func TestParseOpenLyrics(t *testing.T) {
+	t.Run("should read the verses in their order with the chords", func(t *testing.T) {
+		song, err := songs.ParseOpenLyrics(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<song xmlns="http://openlyrics.info/namespace/2009/song" version="0.9">
+  <properties>
+    <titles><title>Cuán grande es Él</title></titles>
+    <authors><author>Carl Boberg</author></authors>
+    <key>bb</key>
+    <verseOrder>v1 c v1</verseOrder>
+  </properties>
+  <lyrics>
+    <verse name="v1"><lines><chord name="Bb"/>Señor, mi Dios<br/>al contemplar los <chord name="Eb"/>cielos</lines></verse>
+    <verse name="c"><lines><comment>todos</comment>Mi corazón entona la canción</lines></verse>
+  </lyrics>
+</song>`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Cuán grande es Él", song.Title)
+		assert.Equal(t, "Bb", song.Key)
+		require.Len(t, song.Segments, 2)
+		assert.Equal(t, "Señor, mi Dios\nal contemplar los cielos", song.Segments[0].Content)
+		assert.Equal(t, "[Bb]Señor, mi Dios\nal contemplar los [Eb]cielos", song.Segments[0].Chords)
+		assert.Equal(t, "Mi corazón entona la canción", song.Segments[1].Content)
+		require.Len(t, song.Arrangements, 1)
+		assert.Equal(t, []string{"v1", "c", "v1"}, song.Arrangements[0].Sequence)
+	})
+
+	t.Run("should fail on a file that is not a song", func(t *testing.T) {
+		_, err := songs.ParseOpenLyrics(strings.NewReader(`<html><body>Santo</body></html>`))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWriteOpenLyrics(t *testing.T) {
+	t.Run("should write a song that reads back the same", func(t *testing.T) {
+		song := parsedSong(t, sublimeGracia)
+		var out bytes.Buffer
+
+		require.NoError(t, songs.WriteOpenLyrics(&out, song))
+
+		assert.Contains(t, out.String(), "<verseOrder>v1 c1 v2 c1</verseOrder>")
+		again, err := songs.ParseOpenLyrics(&out)
+		require.NoError(t, err)
+		assert.Equal(t, song.Title, again.Title)
+		assert.Equal(t, song.Authors, again.Authors)
+		assert.Equal(t, song.Key, again.Key)
+		assert.Equal(t, song.Tempo, again.Tempo)
+		require.Len(t, again.Segments, len(song.Segments))
+		for i, segment := range song.Segments {
+			assert.Equal(t, segment.Content, again.Segments[i].Content)
+			assert.Equal(t, segment.Chords, again.Segments[i].Chords)
+		}
+		assert.Equal(t, song.Arrangements[0].Sequence, again.Arrangements[0].Sequence)
+	})
+}
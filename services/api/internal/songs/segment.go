@@ -0,0 +1,234 @@
+package songs
+
+import (
+	"fmt"
+	"regexp"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"strconv"
+	"strings"
+)
+
+// DefaultSlideLines is how many lines a slide holds when no limit is given.
+const DefaultSlideLines = 4
+
+// repeatMark matches the "x2", "(x2)" or "2x" written after a section label
+// to sing it more than once.
+var repeatMark = regexp.MustCompile(`(?i)\s*[(\[]?\s*(?:[x×]\s*([2-9])|([2-9])\s*[x×])\s*[)\]]?$`)
+
+// lyricWords are section words that are also sung. Followed by text on the
+// same line they are lyrics: "Final:" starts the outro, but "Final: todo
+// se acaba" is a line of it.
+var lyricWords = map[string]bool{"final": true, "puente": true, "ponte": true}
+
+// lyricsBlock is a stanza of a pasted text. Marked blocks came with a
+// label, which gives their kind; a marked block without lines sings an
+// earlier section again.
+type lyricsBlock struct {
+	label  string
+	kind   string
+	marked bool
+	repeat int
+	lines  []string
+}
+
+// SplitLyrics proposes the segments of a pasted text. Sections start at a
+// label such as "[Verso 1]", "Coro" or "Chorus:", or else after a blank
+// line; a label alone sings that section again, and "Coro x2" sings it
+// twice, as does a "(x2)" line after it. Unlabelled stanzas that come
+// back are taken for the chorus, and every repeat points at the same
+// segment. Stanzas longer than maxLines are split into slides of even
+// length, "v1a" and "v1b"; with maxLines 0 they are left whole.
+func SplitLyrics(text string, maxLines int) entities.LyricsSegmentation {
+	blocks := lyricsBlocks(text)
+
+	seen := map[string]int{}
+	for _, block := range blocks {
+		if len(block.lines) > 0 {
+			seen[TitleKey(strings.Join(block.lines, " "))]++
+		}
+	}
+
+	split := entities.LyricsSegmentation{Segments: []entities.LyricsSegment{}, Sequence: []string{}}
+	byText := map[string][]string{}
+	byLabel := map[string][]string{}
+	byKind := map[string][]string{}
+	counts := map[string]int{}
+	for _, block := range blocks {
+		label := TitleKey(block.label)
+		var ids []string
+		if len(block.lines) == 0 {
+			if ids = byLabel[label]; ids == nil {
+				ids = byKind[block.kind]
+			}
+		} else {
+			key := TitleKey(strings.Join(block.lines, " "))
+			if ids = byText[key]; ids == nil {
+				kind := block.kind
+				if !block.marked && seen[key] > 1 {
+					kind = consts.SegmentChorus
+				}
+				letter := verseLetters[kind]
+				counts[letter]++
+				title := block.label
+				if title == "" {
+					title = sectionTitle(kind, counts[letter])
+				}
+				parts := slideLines(block.lines, maxLines)
+				for i, lines := range parts {
+					id := fmt.Sprintf("%s%d", letter, counts[letter])
+					partTitle := title
+					if len(parts) > 1 {
+						id += partSuffix(i)
+						partTitle = fmt.Sprintf("%s (%d/%d)", title, i+1, len(parts))
+					}
+					split.Segments = append(split.Segments, newSegment(id, partTitle, kind, strings.Join(lines, "\n")))
+					ids = append(ids, id)
+				}
+				byText[key] = ids
+				byKind[kind] = ids
+			}
+			if label != "" {
+				byLabel[label] = ids
+			}
+		}
+		for i := 0; i < block.repeat; i++ {
+			split.Sequence = append(split.Sequence, ids...)
+		}
+	}
+	return split
+}
+
+// lyricsBlocks cuts a pasted text into stanzas at labels and blank lines.
+func lyricsBlocks(text string) []lyricsBlock {
+	var (
+		blocks  []lyricsBlock
+		current *lyricsBlock
+	)
+	flush := func() {
+		if current != nil {
+			blocks = append(blocks, *current)
+		}
+		current = nil
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r", ""), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			flush()
+			continue
+		}
+		if match := repeatMark.FindStringSubmatch(line); match != nil && match[0] == line {
+			switch {
+			case current != nil:
+				current.repeat = repeatCount(match)
+			case len(blocks) > 0:
+				blocks[len(blocks)-1].repeat = repeatCount(match)
+			}
+			continue
+		}
+		if label, kind, repeat, rest, ok := sectionMarker(line); ok {
+			flush()
+			current = &lyricsBlock{label: label, kind: kind, marked: true, repeat: repeat}
+			if rest != "" {
+				current.lines = append(current.lines, rest)
+			}
+			continue
+		}
+		if current == nil {
+			current = &lyricsBlock{kind: consts.SegmentVerse, repeat: 1}
+		}
+		current.lines = append(current.lines, line)
+	}
+	flush()
+	return blocks
+}
+
+// sectionMarker reads a label line: "[Verso 1]", "(Coro x2)", "Puente" or
+// "Chorus:", the latter maybe followed by the first line of the section.
+// Outside brackets a label holds only section words and numbers, so lyrics
+// such as "Final de la historia" are not taken for one.
+func sectionMarker(line string) (label string, kind string, repeat int, rest string, ok bool) {
+	label = line
+	bracketed := false
+	if n := len(line); n > 2 && (line[0] == '[' && line[n-1] == ']' || line[0] == '(' && line[n-1] == ')') {
+		label, bracketed = line[1:n-1], true
+	} else if head, tail, found := strings.Cut(line, ":"); found {
+		label, rest = head, strings.TrimSpace(tail)
+	}
+
+	repeat = 1
+	if match := repeatMark.FindStringSubmatch(label); match != nil {
+		repeat = repeatCount(match)
+		label = strings.TrimSuffix(label, match[0])
+	}
+	label = collapse(label)
+
+	kind, ok = SectionKind(label)
+	if !ok {
+		return "", "", 0, "", false
+	}
+	if !bracketed {
+		words := strings.Fields(TitleKey(label))
+		if rest != "" && len(words) == 1 && lyricWords[words[0]] {
+			return "", "", 0, "", false
+		}
+		for _, word := range words[1:] {
+			if _, section := sectionWords[word]; !section && !isNumber(word) {
+				return "", "", 0, "", false
+			}
+		}
+	}
+	return label, kind, repeat, rest, true
+}
+
+// repeatCount reads how many times a repeatMark match sings a section.
+func repeatCount(match []string) int {
+	count := match[1]
+	if count == "" {
+		count = match[2]
+	}
+	repeat, _ := strconv.Atoi(count)
+	return repeat
+}
+
+// slideLines splits a stanza into slides of at most maxLines lines, as
+// even as they can be: seven lines at four per slide are four and three.
+func slideLines(lines []string, maxLines int) [][]string {
+	if maxLines <= 0 || len(lines) <= maxLines {
+		return [][]string{lines}
+	}
+	parts := (len(lines) + maxLines - 1) / maxLines
+	size := (len(lines) + parts - 1) / parts
+	var slides [][]string
+	for start := 0; start < len(lines); start += size {
+		end := start + size
+		if end > len(lines) {
+			end = len(lines)
+		}
+		slides = append(slides, lines[start:end])
+	}
+	return slides
+}
+
+// sectionTitle names a section that came without a label: "Verso 2",
+// "Coro", and "Coro 2" for a second, different chorus.
+func sectionTitle(kind string, number int) string {
+	if kind != consts.SegmentVerse && number > 1 {
+		return fmt.Sprintf("%s %d", kind, number)
+	}
+	return defaultSectionTitle(kind, number)
+}
+
+// partSuffix names the slides of a split stanza "a", "b", ..., as the
+// verse parts of OpenLyrics, and numbers them past the alphabet.
+func partSuffix(i int) string {
+	if i < 26 {
+		return string(rune('a' + i))
+	}
+	return fmt.Sprintf("-%d", i+1)
+}
+
+func isNumber(word string) bool {
+	_, err := strconv.Atoi(word)
+	return err == nil
+}
@@ -0,0 +1,93 @@
+package songs_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"services/api/domain/entities"
+	"services/api/internal/songs"
+	"strings"
+	"testing"
+)
+
+func TestSplitLyrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxLines int
+		segments []string
+		sequence []string
+	}{
+		{
+			name:     "should start a section at every kind of label",
+			text:     "[Verso 1]\nSublime gracia\n(Coro)\nGloria a Dios\nPuente\nSanto\nChorus: Aleluya\nFinal:\nAmén",
+			segments: []string{"v1 Verso 1 Verso: Sublime gracia", "c1 Coro Coro: Gloria a Dios", "b1 Puente Puente: Santo", "c2 Chorus Coro: Aleluya", "e1 Final Outro: Amén"},
+			sequence: []string{"v1", "c1", "b1", "c2", "e1"},
+		},
+		{
+			name:     "should split stanzas at blank lines",
+			text:     "Sublime gracia\ndel Señor\n\n\nQue a un pecador\nsalvó",
+			segments: []string{"v1 Verso 1 Verso: Sublime gracia\ndel Señor", "v2 Verso 2 Verso: Que a un pecador\nsalvó"},
+			sequence: []string{"v1", "v2"},
+		},
+		{
+			name:     "should take a stanza that comes back for the chorus",
+			text:     "Sublime gracia\n\nGloria a Dios\n\nQue a un pecador\n\nGloria a Dios",
+			segments: []string{"v1 Verso 1 Verso: Sublime gracia", "c1 Coro Coro: Gloria a Dios", "v2 Verso 2 Verso: Que a un pecador"},
+			sequence: []string{"v1", "c1", "v2", "c1"},
+		},
+		{
+			name:     "should sing a label alone again and repeat it as marked",
+			text:     "Coro\nGloria a Dios\n\nVerso\nSublime gracia\n\nCoro x2",
+			segments: []string{"c1 Coro Coro: Gloria a Dios", "v1 Verso Verso: Sublime gracia"},
+			sequence: []string{"c1", "v1", "c1", "c1"},
+		},
+		{
+			name:     "should repeat a section on a line of its own",
+			text:     "Santo, santo, santo\n(x2)\n\nCoro\nGloria a Dios\n\n2x",
+			segments: []string{"v1 Verso 1 Verso: Santo, santo, santo", "c1 Coro Coro: Gloria a Dios"},
+			sequence: []string{"v1", "v1", "c1", "c1"},
+		},
+		{
+			name:     "should take lines that start with a section word for lyrics",
+			text:     "Final: todo se acaba\nPuente: de tu amor\nFinal de la historia",
+			segments: []string{"v1 Verso 1 Verso: Final: todo se acaba\nPuente: de tu amor\nFinal de la historia"},
+			sequence: []string{"v1"},
+		},
+		{
+			name:     "should split long stanzas into even slides",
+			text:     "uno\ndos\ntres\ncuatro\ncinco\nseis\nsiete",
+			maxLines: 4,
+			segments: []string{"v1a Verso 1 (1/2) Verso: uno\ndos\ntres\ncuatro", "v1b Verso 1 (2/2) Verso: cinco\nseis\nsiete"},
+			sequence: []string{"v1a", "v1b"},
+		},
+		{
+			name:     "should split into slides of at most the line limit",
+			text:     "uno\ndos\ntres\ncuatro\ncinco",
+			maxLines: 2,
+			segments: []string{"v1a Verso 1 (1/3) Verso: uno\ndos", "v1b Verso 1 (2/3) Verso: tres\ncuatro", "v1c Verso 1 (3/3) Verso: cinco"},
+			sequence: []string{"v1a", "v1b", "v1c"},
+		},
+		{
+			name:     "should leave stanzas whole without a line limit",
+			text:     "uno\ndos\ntres\ncuatro\ncinco",
+			segments: []string{"v1 Verso 1 Verso: uno\ndos\ntres\ncuatro\ncinco"},
+			sequence: []string{"v1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			split := songs.SplitLyrics(tt.text, tt.maxLines)
+
+			assert.Equal(t, tt.segments, describeSegments(split.Segments))
+			assert.Equal(t, tt.sequence, split.Sequence)
+		})
+	}
+}
+
+// describeSegments writes every segment as "<id> <title> <kind>: <content>".
+func describeSegments(segments []entities.LyricsSegment) []string {
+	described := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		described = append(described, strings.Join([]string{segment.ID, segment.Title, segment.Kind + ":", segment.Content}, " "))
+	}
+	return described
+}
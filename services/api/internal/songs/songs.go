@@ -0,0 +1,192 @@
+// Package songs reads and writes song files (OpenLyrics and ChordPro) and
+// turns their sections into the segments LyricsStudio projects.
+package songs
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"services/api/domain/consts"
+	"services/api/domain/entities"
+	"sort"
+	"strings"
+)
+
+// newSegment builds a segment with the color of its kind.
+func newSegment(id string, title string, kind string, content string) entities.LyricsSegment {
+	color, ok := consts.SegmentColors[kind]
+	if !ok {
+		kind = consts.SegmentVerse
+		color = consts.SegmentColors[kind]
+	}
+	return entities.LyricsSegment{ID: id, Title: title, Content: content, Kind: kind, Color: color}
+}
+
+// CreditLine is the line shown under the last slide of a song: its
+// authors, copyright and CCLI number, as in
+// "John Newton · © Dominio público · CCLI 22025". It is empty when the
+// song has none of them.
+func CreditLine(song entities.LyricsSong) string {
+	var parts []string
+	if len(song.Authors) > 0 {
+		parts = append(parts, strings.Join(song.Authors, ", "))
+	}
+	if copyright := strings.TrimSpace(song.Copyright); copyright != "" {
+		if !strings.HasPrefix(copyright, "©") {
+			copyright = "© " + copyright
+		}
+		parts = append(parts, copyright)
+	}
+	if song.CCLI != "" {
+		parts = append(parts, "CCLI "+song.CCLI)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// sectionWords maps the words that name a section, in Spanish, English or
+// Portuguese, onto its kind.
+var sectionWords = map[string]string{
+	"verso":        consts.SegmentVerse,
+	"verse":        consts.SegmentVerse,
+	"estrofa":      consts.SegmentVerse,
+	"coro":         consts.SegmentChorus,
+	"chorus":       consts.SegmentChorus,
+	"estribillo":   consts.SegmentChorus,
+	"refrain":      consts.SegmentChorus,
+	"refrao":       consts.SegmentChorus,
+	"precoro":      consts.SegmentChorus,
+	"prechorus":    consts.SegmentChorus,
+	"puente":       consts.SegmentBridge,
+	"bridge":       consts.SegmentBridge,
+	"ponte":        consts.SegmentBridge,
+	"intro":        consts.SegmentIntro,
+	"introduccion": consts.SegmentIntro,
+	"outro":        consts.SegmentOutro,
+	"final":        consts.SegmentOutro,
+	"ending":       consts.SegmentOutro,
+	"coda":         consts.SegmentOutro,
+}
+
+// SectionKind reads the kind out of a section label such as "Verso 2",
+// "Pre-coro" or "Chorus", and tells whether the label names one.
+func SectionKind(label string) (string, bool) {
+	words := strings.Fields(TitleKey(label))
+	if len(words) == 0 {
+		return "", false
+	}
+	word := words[0]
+	if word == "pre" && len(words) > 1 {
+		word += words[1]
+	}
+	kind, ok := sectionWords[word]
+	return kind, ok
+}
+
+// TitleKey folds a song title so "Cuán Grande Es Él" and "cuan grande es el"
+// compare equal when matching imports against the library.
+func TitleKey(title string) string {
+	folded := titleReplacer.Replace(strings.ToLower(title))
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(folded, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	}) {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(word)
+	}
+	return b.String()
+}
+
+var titleReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+	"â", "a", "ã", "a", "à", "a", "ê", "e", "ô", "o", "õ", "o", "ç", "c",
+)
+
+// joinLines trims every line and drops the blank ones at both ends.
+func joinLines(lines []string) string {
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// readSongFiles reads an uploaded song file, or every file of a zip that
+// isSong accepts, with parse.
+func readSongFiles(
+	name string,
+	content []byte,
+	format string,
+	isSong func(file string) bool,
+	parse func(file string, r io.Reader) (*entities.LyricsSongPayload, error),
+) ([]entities.LyricsImportFile, error) {
+	if !bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		song, err := parse(name, bytes.NewReader(content))
+		if err != nil {
+			return []entities.LyricsImportFile{{File: name, Error: err.Error()}}, nil
+		}
+		return []entities.LyricsImportFile{{File: name, Song: song}}, nil
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip: %w", err)
+	}
+	files := make([]*zip.File, 0, len(archive.File))
+	for _, f := range archive.File {
+		base := path.Base(f.Name)
+		if f.FileInfo().IsDir() || strings.HasPrefix(base, ".") || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		if isSong(base) {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no " + format + " files found")
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	read := make([]entities.LyricsImportFile, 0, len(files))
+	for _, f := range files {
+		item := entities.LyricsImportFile{File: f.Name}
+		src, err := f.Open()
+		if err != nil {
+			item.Error = err.Error()
+			read = append(read, item)
+			continue
+		}
+		item.Song, err = parse(f.Name, src)
+		src.Close()
+		if err != nil {
+			item.Error = err.Error()
+		}
+		read = append(read, item)
+	}
+	return read, nil
+}
+
+// writeSongZip writes every song as its own file of a zip, numbering the
+// files of songs that share a title.
+func writeSongZip(w io.Writer, songs []entities.LyricsSong, ext string, write func(io.Writer, entities.LyricsSong) error) error {
+	archive := zip.NewWriter(w)
+	used := map[string]int{}
+	for _, song := range songs {
+		name := FileName(song.Title, ext)
+		used[name]++
+		if used[name] > 1 {
+			name = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), used[name], ext)
+		}
+		file, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := write(file, song); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
@@ -0,0 +1,259 @@
+// Package topics reads topical indexes such as Nave's Topical Bible: topics
+// mapped to the references that speak about them.
+package topics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"services/api/domain/entities"
+	"services/api/internal/bibleref"
+	"services/api/internal/crossref"
+	"sort"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// DetectFormat picks the format from the file extension, CSV by default.
+func DetectFormat(filename string) string {
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		return FormatJSON
+	}
+	return FormatCSV
+}
+
+// Parse reads a topical index in the given format. Topics that appear
+// several times are merged in file order. References that cannot be read
+// are counted in skipped instead of failing the whole file.
+func Parse(format string, r io.Reader) ([]entities.TopicImport, int, error) {
+	index := newIndex()
+	var err error
+	switch format {
+	case FormatJSON:
+		err = parseJSON(r, index)
+	case FormatCSV:
+		err = parseCSV(r, index)
+	default:
+		return nil, 0, fmt.Errorf("unknown topic format %q", format)
+	}
+	if err != nil {
+		return nil, index.skipped, err
+	}
+	if len(index.topics) == 0 {
+		return nil, index.skipped, errors.New("no topics found")
+	}
+	return index.topics, index.skipped, nil
+}
+
+type jsonTopic struct {
+	Topic      string          `json:"topic"`
+	Name       string          `json:"name"`
+	Subtopic   string          `json:"subtopic"`
+	References json.RawMessage `json:"references"`
+}
+
+// parseJSON reads [{"topic": "Paz", "subtopic": "...", "references":
+// ["Juan 14:27", "Fil 4:7"]}], the same list under a "topics" key, or an
+// object mapping each topic to its references, which is read in name order
+// since an object keeps none. References may also be one string separated
+// by ";".
+func parseJSON(r io.Reader, index *topicIndex) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	content = bytes.TrimSpace(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+
+	var list []jsonTopic
+	if bytes.HasPrefix(content, []byte("[")) {
+		err = json.Unmarshal(content, &list)
+	} else {
+		var wrapped struct {
+			Topics []jsonTopic `json:"topics"`
+		}
+		if err = json.Unmarshal(content, &wrapped); err == nil && wrapped.Topics != nil {
+			list = wrapped.Topics
+		} else {
+			var byName map[string]json.RawMessage
+			if err = json.Unmarshal(content, &byName); err == nil {
+				names := make([]string, 0, len(byName))
+				for name := range byName {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					list = append(list, jsonTopic{Topic: name, References: byName[name]})
+				}
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+
+	for i, item := range list {
+		name := item.Topic
+		if name == "" {
+			name = item.Name
+		}
+		var refs []string
+		if err := json.Unmarshal(item.References, &refs); err != nil {
+			var single string
+			if err := json.Unmarshal(item.References, &single); err != nil {
+				return fmt.Errorf("topic %d: references must be a string or a list of strings", i+1)
+			}
+			refs = []string{single}
+		}
+		for _, ref := range refs {
+			index.add(name, item.Subtopic, ref)
+		}
+	}
+	return nil
+}
+
+// parseCSV reads "topic,reference" or "topic,subtopic,reference" rows, with
+// an optional header naming the columns (topic/tema, subtopic/subtema,
+// reference/referencia). A reference cell may hold several references
+// separated by ";". Comma and tab separators are detected from the first
+// line.
+func parseCSV(r io.Reader, index *topicIndex) error {
+	buffered := bufio.NewReader(r)
+	head, _ := buffered.Peek(1024)
+	if bytes.HasPrefix(head, []byte("\xef\xbb\xbf")) {
+		_, _ = buffered.Discard(3)
+		head = head[3:]
+	}
+
+	reader := csv.NewReader(buffered)
+	if firstLine, _, _ := bytes.Cut(head, []byte("\n")); bytes.Count(firstLine, []byte("\t")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = '\t'
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var columns map[string]int
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid csv: %w", err)
+		}
+		line++
+		if len(record) < 2 {
+			if strings.TrimSpace(strings.Join(record, "")) != "" {
+				index.skipped++
+			}
+			continue
+		}
+		if line == 1 {
+			if header := csvColumns(record); header != nil {
+				columns = header
+				continue
+			}
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+		if columns == nil {
+			columns = map[string]int{"topic": 0, "reference": len(record) - 1}
+			if len(record) > 2 {
+				columns["subtopic"] = 1
+			}
+		}
+		for _, ref := range strings.Split(field("reference"), ";") {
+			index.add(field("topic"), field("subtopic"), ref)
+		}
+	}
+	return nil
+}
+
+// csvColumns maps a header row, or returns nil when the row is data.
+func csvColumns(header []string) map[string]int {
+	aliases := map[string]string{
+		"topic": "topic", "tema": "topic", "tópico": "topic", "topico": "topic",
+		"subtopic": "subtopic", "subtema": "subtopic",
+		"reference": "reference", "references": "reference", "referencia": "reference", "referencias": "reference",
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		if column, ok := aliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[column] = i
+		}
+	}
+	if _, ok := columns["topic"]; !ok {
+		return nil
+	}
+	if _, ok := columns["reference"]; !ok {
+		return nil
+	}
+	return columns
+}
+
+type topicIndex struct {
+	topics  []entities.TopicImport
+	byName  map[string]int
+	skipped int
+}
+
+func newIndex() *topicIndex {
+	return &topicIndex{byName: map[string]int{}}
+}
+
+// add files one reference under a topic. A subtopic becomes part of the
+// name, "Paz, espiritual", so it can be searched on its own.
+func (t *topicIndex) add(topic string, subtopic string, ref string) {
+	name := strings.TrimSpace(topic)
+	if subtopic = strings.TrimSpace(subtopic); subtopic != "" {
+		name += ", " + subtopic
+	}
+	if strings.TrimSpace(ref) == "" {
+		return
+	}
+	ranges, err := parseReferences(ref)
+	if name == "" || err != nil {
+		t.skipped++
+		return
+	}
+
+	i, ok := t.byName[strings.ToLower(name)]
+	if !ok {
+		i = len(t.topics)
+		t.byName[strings.ToLower(name)] = i
+		t.topics = append(t.topics, entities.TopicImport{Name: name})
+	}
+	t.topics[i].References = append(t.topics[i].References, ranges...)
+}
+
+// parseReferences reads anything bibleref.Parse understands and falls back
+// to the OSIS ids of crossref.ParseReference, as in "Gen.1.1".
+func parseReferences(ref string) ([]entities.ReferenceRange, error) {
+	ranges, err := bibleref.Parse(ref)
+	if err == nil {
+		for i := range ranges {
+			ranges[i].Reference = bibleref.Format(ranges[i])
+		}
+		return ranges, nil
+	}
+	single, osisErr := crossref.ParseReference(ref)
+	if osisErr != nil {
+		return nil, err
+	}
+	return []entities.ReferenceRange{single}, nil
+}
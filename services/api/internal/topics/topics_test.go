@@ -0,0 +1,97 @@
+package topics_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"services/api/domain/entities"
+	"services/api/internal/topics"
+	"strings"
+	"testing"
+)
+
+func TestParse_JSON(t *testing.T) {
+	t.Run("should read a list of topics with subtopics", func(t *testing.T) {
+		index, skipped, err := topics.Parse(topics.FormatJSON, strings.NewReader(`[
+			{"topic": "Paz", "references": ["Juan 14:27", "Fil 4:7"]},
+			{"topic": "Paz", "subtopic": "espiritual", "references": "Rom 5:1; Is 26:3"}
+		]`))
+
+		require.NoError(t, err)
+		assert.Zero(t, skipped)
+		assert.Equal(t, []string{"Paz", "Paz, espiritual"}, topicNames(index))
+		assert.Equal(t, []string{"Juan 14:27", "Filipenses 4:7"}, topicReferences(index[0]))
+		assert.Equal(t, []string{"Romanos 5:1", "Isaías 26:3"}, topicReferences(index[1]))
+	})
+
+	t.Run("should read an object of topics in name order", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			index, _, err := topics.Parse(topics.FormatJSON, strings.NewReader(`{
+				"Perdón": ["1 Jn 1:9"],
+				"Amor": ["1 Co 13:4-7"],
+				"Fe": ["He 11:1"],
+				"Esperanza": ["Ro 15:13"]
+			}`))
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"Amor", "Esperanza", "Fe", "Perdón"}, topicNames(index))
+		}
+	})
+
+	t.Run("should read the list under a topics key", func(t *testing.T) {
+		index, _, err := topics.Parse(topics.FormatJSON, strings.NewReader(`{"topics": [{"name": "Gozo", "references": ["Fil 4:4"]}]}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Gozo"}, topicNames(index))
+	})
+}
+
+func TestParse_CSV(t *testing.T) {
+	t.Run("should merge the rows of a topic and count what cannot be read", func(t *testing.T) {
+		index, skipped, err := topics.Parse(topics.FormatCSV, strings.NewReader("tema,subtema,referencia\n"+
+			"Paz,,Juan 14:27; Fil 4:7\n"+
+			"Oración,,Mt 6:9-13\n"+
+			"Paz,,Xyz 3\n"+
+			"Paz,,Gen.1.1\n"+
+			"Oración,ayuno,Mt 6:16\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, skipped)
+		assert.Equal(t, []string{"Paz", "Oración", "Oración, ayuno"}, topicNames(index))
+		assert.Equal(t, []string{"Juan 14:27", "Filipenses 4:7", "Génesis 1:1"}, topicReferences(index[0]))
+	})
+
+	t.Run("should read rows without a header", func(t *testing.T) {
+		index, _, err := topics.Parse(topics.FormatCSV, strings.NewReader("Paz\tJuan 14:27\nGozo\tFil 4:4\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Paz", "Gozo"}, topicNames(index))
+	})
+
+	t.Run("should fail on a file without topics", func(t *testing.T) {
+		_, _, err := topics.Parse(topics.FormatCSV, strings.NewReader("tema,referencia\n"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, topics.FormatJSON, topics.DetectFormat("naves.JSON"))
+	assert.Equal(t, topics.FormatCSV, topics.DetectFormat("naves.csv"))
+	assert.Equal(t, topics.FormatCSV, topics.DetectFormat("naves.txt"))
+}
+
+func topicNames(index []entities.TopicImport) []string {
+	names := make([]string, 0, len(index))
+	for _, topic := range index {
+		names = append(names, topic.Name)
+	}
+	return names
+}
+
+func topicReferences(topic entities.TopicImport) []string {
+	references := make([]string, 0, len(topic.References))
+	for _, ref := range topic.References {
+		references = append(references, ref.Reference)
+	}
+	return references
+}
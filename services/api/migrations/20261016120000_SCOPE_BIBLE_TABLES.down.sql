@@ -0,0 +1,58 @@
+DROP INDEX IF EXISTS idx_chapters_verses_ref;
+DELETE FROM chapters_verses WHERE bible_id != 1;
+ALTER TABLE chapters_verses DROP COLUMN bible_id;
+CREATE INDEX IF NOT EXISTS idx_chapters_verses_ref ON chapters_verses(book, chapter);
+
+CREATE TABLE books_legacy
+(
+    name     VARCHAR(60) PRIMARY KEY,
+    title    VARCHAR(60) NOT NULL,
+    bible_id INTEGER NOT NULL DEFAULT 1
+);
+
+INSERT INTO books_legacy (name, title, bible_id)
+SELECT name, title, bible_id FROM books WHERE bible_id = 1;
+
+CREATE TABLE chapters_legacy
+(
+    id       INTEGER PRIMARY KEY,
+    book     TEXT NOT NULL,
+    "index"  INTEGER NOT NULL,
+    research TEXT,
+    title    TEXT,
+    FOREIGN KEY (book) REFERENCES books_legacy (name)
+);
+
+INSERT INTO chapters_legacy (id, book, "index", research, title)
+SELECT c.id, b.name, c."index", c.research, c.title
+FROM chapters c INNER JOIN books b ON b.id = c.book_id
+WHERE b.bible_id = 1;
+
+CREATE TABLE verses_legacy
+(
+    id       INTEGER PRIMARY KEY,
+    chapter  INTEGER NOT NULL,
+    research TEXT,
+    "index"  INTEGER NOT NULL,
+    content  TEXT NOT NULL,
+    FOREIGN KEY (chapter) REFERENCES chapters_legacy (id)
+);
+
+INSERT INTO verses_legacy (id, chapter, research, "index", content)
+SELECT v.id, v.chapter, v.research, v."index", v.content
+FROM verses v INNER JOIN chapters_legacy c ON c.id = v.chapter;
+
+DROP TABLE verses;
+DROP TABLE chapters;
+DROP TABLE books;
+
+ALTER TABLE books_legacy RENAME TO books;
+ALTER TABLE chapters_legacy RENAME TO chapters;
+ALTER TABLE verses_legacy RENAME TO verses;
+
+CREATE INDEX IF NOT EXISTS idx_chapters_book_index ON chapters(book, "index");
+CREATE INDEX IF NOT EXISTS idx_verses_chapter_index ON verses(chapter, "index");
+
+DELETE FROM bibles WHERE id != 1;
+ALTER TABLE bibles DROP COLUMN language;
+ALTER TABLE bibles DROP COLUMN abbreviation;
@@ -0,0 +1,60 @@
+ALTER TABLE bibles ADD COLUMN abbreviation TEXT NOT NULL DEFAULT '';
+ALTER TABLE bibles ADD COLUMN language TEXT NOT NULL DEFAULT '';
+
+UPDATE bibles SET abbreviation = 'RVR1960', language = 'es' WHERE id = 1;
+
+CREATE TABLE books_scoped
+(
+    id       INTEGER PRIMARY KEY,
+    bible_id INTEGER NOT NULL,
+    name     TEXT    NOT NULL,
+    title    TEXT    NOT NULL,
+    UNIQUE (bible_id, name),
+    FOREIGN KEY (bible_id) REFERENCES bibles (id)
+);
+
+INSERT INTO books_scoped (bible_id, name, title)
+SELECT bible_id, name, title FROM books ORDER BY rowid;
+
+CREATE TABLE chapters_scoped
+(
+    id       INTEGER PRIMARY KEY,
+    book_id  INTEGER NOT NULL,
+    "index"  INTEGER NOT NULL,
+    research TEXT,
+    title    TEXT,
+    FOREIGN KEY (book_id) REFERENCES books_scoped (id)
+);
+
+INSERT INTO chapters_scoped (id, book_id, "index", research, title)
+SELECT c.id, b.id, c."index", c.research, c.title
+FROM chapters c INNER JOIN books_scoped b ON b.name = c.book;
+
+CREATE TABLE verses_scoped
+(
+    id       INTEGER PRIMARY KEY,
+    chapter  INTEGER NOT NULL,
+    research TEXT,
+    "index"  INTEGER NOT NULL,
+    content  TEXT    NOT NULL,
+    FOREIGN KEY (chapter) REFERENCES chapters_scoped (id)
+);
+
+INSERT INTO verses_scoped (id, chapter, research, "index", content)
+SELECT id, chapter, research, "index", content FROM verses;
+
+DROP TABLE verses;
+DROP TABLE chapters;
+DROP TABLE books;
+
+ALTER TABLE books_scoped RENAME TO books;
+ALTER TABLE chapters_scoped RENAME TO chapters;
+ALTER TABLE verses_scoped RENAME TO verses;
+
+CREATE INDEX IF NOT EXISTS idx_chapters_book_index ON chapters(book_id, "index");
+CREATE INDEX IF NOT EXISTS idx_verses_chapter_index ON verses(chapter, "index");
+
+ALTER TABLE chapters_verses ADD COLUMN bible_id INTEGER NOT NULL DEFAULT 1;
+
+DROP INDEX IF EXISTS idx_chapters_verses_ref;
+CREATE INDEX IF NOT EXISTS idx_chapters_verses_ref ON chapters_verses(bible_id, book, chapter);
@@ -0,0 +1,26 @@
+DROP TABLE IF EXISTS verses_fts;
+CREATE VIRTUAL TABLE IF NOT EXISTS verses_fts USING fts5
+(
+    content,
+    content = 'verses',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS verses_fts_insert AFTER INSERT ON verses
+BEGIN
+    INSERT INTO verses_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS verses_fts_delete AFTER DELETE ON verses
+BEGIN
+    INSERT INTO verses_fts(verses_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS verses_fts_update AFTER UPDATE OF content ON verses
+BEGIN
+    INSERT INTO verses_fts(verses_fts, rowid, content) VALUES ('delete', old.id, old.content);
+    INSERT INTO verses_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+INSERT INTO verses_fts(verses_fts) VALUES ('rebuild');
@@ -0,0 +1,4 @@
+ALTER TABLE bibles DROP COLUMN versification;
+
+DROP INDEX IF EXISTS idx_versification_mappings_book;
+DROP TABLE IF EXISTS versification_mappings;
@@ -0,0 +1,16 @@
+CREATE TABLE versification_mappings
+(
+    id             INTEGER PRIMARY KEY,
+    versification  TEXT    NOT NULL,
+    book           TEXT    NOT NULL,
+    first_chapter  INTEGER NOT NULL,
+    last_chapter   INTEGER NOT NULL,
+    first_verse    INTEGER NOT NULL,
+    last_verse     INTEGER NOT NULL,
+    chapter_offset INTEGER NOT NULL,
+    verse_offset   INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_versification_mappings_book ON versification_mappings(versification, book);
+
+ALTER TABLE bibles ADD COLUMN versification TEXT NOT NULL DEFAULT 'standard';
@@ -0,0 +1,9 @@
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 9, 9, 22, 39, 1, -21);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 10, 112, 1, 999, 1, 0);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 113, 113, 1, 8, 1, 0);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 113, 113, 9, 26, 2, -8);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 114, 114, 1, 9, 2, 0);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 115, 115, 1, 10, 1, 9);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 116, 145, 1, 999, 1, 0);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 146, 146, 1, 11, 1, 0);
+INSERT INTO versification_mappings (versification, book, first_chapter, last_chapter, first_verse, last_verse, chapter_offset, verse_offset) VALUES ('lxx', 'salmos', 147, 147, 1, 9, 0, 11);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_cross_references_verse;
+DROP TABLE IF EXISTS cross_references;
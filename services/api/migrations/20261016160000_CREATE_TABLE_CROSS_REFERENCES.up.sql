@@ -0,0 +1,15 @@
+CREATE TABLE cross_references
+(
+    id               INTEGER PRIMARY KEY,
+    book             TEXT    NOT NULL,
+    chapter          INTEGER NOT NULL,
+    verse            INTEGER NOT NULL,
+    to_book          TEXT    NOT NULL,
+    to_start_chapter INTEGER NOT NULL,
+    to_start_verse   INTEGER NOT NULL,
+    to_end_chapter   INTEGER NOT NULL,
+    to_end_verse     INTEGER NOT NULL,
+    votes            INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_cross_references_verse ON cross_references(book, chapter, verse);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_live_history_started_at;
+DROP TABLE IF EXISTS live_history;
@@ -0,0 +1,15 @@
+CREATE TABLE live_history
+(
+    id               INTEGER PRIMARY KEY,
+    started_at       TEXT    NOT NULL,
+    ended_at         TEXT    NOT NULL DEFAULT '',
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    type             TEXT    NOT NULL,
+    reference        TEXT    NOT NULL DEFAULT '',
+    song_id          TEXT    NOT NULL DEFAULT '',
+    title            TEXT    NOT NULL DEFAULT '',
+    slides           INTEGER NOT NULL DEFAULT 1,
+    source           TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_live_history_started_at ON live_history(started_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS verse_collection_items;
+DROP TABLE IF EXISTS verse_collections;
@@ -0,0 +1,18 @@
+CREATE TABLE verse_collections
+(
+    id          TEXT PRIMARY KEY,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at  TEXT NOT NULL,
+    updated_at  TEXT NOT NULL
+);
+
+CREATE TABLE verse_collection_items
+(
+    collection_id TEXT    NOT NULL,
+    position      INTEGER NOT NULL,
+    reference     TEXT    NOT NULL,
+    note          TEXT    NOT NULL DEFAULT '',
+    PRIMARY KEY (collection_id, position),
+    FOREIGN KEY (collection_id) REFERENCES verse_collections (id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reading_plan_days;
+DROP TABLE IF EXISTS reading_plans;
@@ -0,0 +1,20 @@
+CREATE TABLE reading_plans
+(
+    id          TEXT PRIMARY KEY,
+    name        TEXT    NOT NULL,
+    description TEXT    NOT NULL DEFAULT '',
+    start_date  TEXT    NOT NULL DEFAULT '',
+    days        INTEGER NOT NULL,
+    created_at  TEXT    NOT NULL,
+    updated_at  TEXT    NOT NULL
+);
+
+CREATE TABLE reading_plan_days
+(
+    plan_id   TEXT    NOT NULL,
+    day       INTEGER NOT NULL,
+    position  INTEGER NOT NULL,
+    reference TEXT    NOT NULL,
+    PRIMARY KEY (plan_id, day, position),
+    FOREIGN KEY (plan_id) REFERENCES reading_plans (id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS topic_references;
+DROP INDEX IF EXISTS idx_topics_normalized;
+DROP TABLE IF EXISTS topics;
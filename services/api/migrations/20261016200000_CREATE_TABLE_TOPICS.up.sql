@@ -0,0 +1,21 @@
+CREATE TABLE topics
+(
+    id         INTEGER PRIMARY KEY,
+    name       TEXT NOT NULL,
+    normalized TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_topics_normalized ON topics(normalized);
+
+CREATE TABLE topic_references
+(
+    topic_id      INTEGER NOT NULL,
+    position      INTEGER NOT NULL,
+    book          TEXT    NOT NULL,
+    start_chapter INTEGER NOT NULL,
+    start_verse   INTEGER NOT NULL,
+    end_chapter   INTEGER NOT NULL,
+    end_verse     INTEGER NOT NULL,
+    PRIMARY KEY (topic_id, position),
+    FOREIGN KEY (topic_id) REFERENCES topics (id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_book_aliases_book;
+DROP TABLE IF EXISTS book_aliases;
@@ -0,0 +1,11 @@
+CREATE TABLE book_aliases
+(
+    id        INTEGER PRIMARY KEY,
+    book      TEXT    NOT NULL,
+    alias     TEXT    NOT NULL,
+    alias_key TEXT    NOT NULL UNIQUE,
+    language  TEXT    NOT NULL DEFAULT '',
+    custom    INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_book_aliases_book ON book_aliases(book);
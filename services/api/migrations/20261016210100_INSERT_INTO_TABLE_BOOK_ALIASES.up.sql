@@ -0,0 +1,385 @@
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('genesis', 'Génesis', 'genesis', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('genesis', 'Gn', 'gn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('exodo', 'Éxodo', 'exodo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('exodo', 'Éx', 'ex', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('levitico', 'Levítico', 'levitico', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('levitico', 'Lv', 'lv', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('numeros', 'Números', 'numeros', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('numeros', 'Nm', 'nm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('deuteronomio', 'Deuteronomio', 'deuteronomio', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('deuteronomio', 'Dt', 'dt', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('deuteronomio', 'Dtn', 'dtn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('josue', 'Josué', 'josue', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('josue', 'Jos', 'jos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Jueces', 'jueces', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Jue', 'jue', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Jc', 'jc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('rut', 'Rut', 'rut', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('rut', 'Rt', 'rt', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-samuel', '1 Samuel', '1samuel', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-samuel', '1 S', '1s', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-samuel', '1 Sm', '1sm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-samuel', '2 Samuel', '2samuel', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-samuel', '2 S', '2s', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-samuel', '2 Sm', '2sm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Reyes', '1reyes', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 R', '1r', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Re', '1re', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Rey', '1rey', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Reyes', '2reyes', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 R', '2r', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Re', '2re', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Rey', '2rey', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Crónicas', '1cronicas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Cr', '1cr', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Cro', '1cro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Cron', '1cron', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Crónicas', '2cronicas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Cr', '2cr', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Cro', '2cro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Cron', '2cron', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('esdras', 'Esdras', 'esdras', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('esdras', 'Esd', 'esd', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nehemias', 'Nehemías', 'nehemias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nehemias', 'Neh', 'neh', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nehemias', 'Ne', 'ne', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ester', 'Ester', 'ester', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ester', 'Est', 'est', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('job', 'Job', 'job', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Salmos', 'salmos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Sal', 'sal', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Salmo', 'salmo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Sl', 'sl', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Proverbios', 'proverbios', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Pr', 'pr', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Pro', 'pro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Eclesiastés', 'eclesiastes', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Ec', 'ec', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Qohelet', 'qohelet', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Ecl', 'ecl', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Qo', 'qo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cantares', 'cantares', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cnt', 'cnt', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cantar de los Cantares', 'cantardeloscantares', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Ct', 'ct', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cant', 'cant', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('isaias', 'Isaías', 'isaias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('isaias', 'Is', 'is', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jeremias', 'Jeremías', 'jeremias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jeremias', 'Jer', 'jer', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jeremias', 'Jr', 'jr', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lamentaciones', 'Lamentaciones', 'lamentaciones', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lamentaciones', 'Lm', 'lm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ezequiel', 'Ezequiel', 'ezequiel', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ezequiel', 'Ez', 'ez', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('daniel', 'Daniel', 'daniel', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('daniel', 'Dn', 'dn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('oseas', 'Oseas', 'oseas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('oseas', 'Os', 'os', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('joel', 'Joel', 'joel', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('joel', 'Jl', 'jl', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('amos', 'Amós', 'amos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('amos', 'Am', 'am', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Abdías', 'abdias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Abd', 'abd', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Ab', 'ab', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jonas', 'Jonás', 'jonas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jonas', 'Jon', 'jon', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Miqueas', 'miqueas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Mi', 'mi', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Miq', 'miq', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nahum', 'Nahum', 'nahum', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nahum', 'Nah', 'nah', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nahum', 'Na', 'na', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('habacuc', 'Habacuc', 'habacuc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('habacuc', 'Hab', 'hab', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('habacuc', 'Ha', 'ha', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Sofonías', 'sofonias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Sof', 'sof', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'So', 'so', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Hageo', 'hageo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Hag', 'hag', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Ag', 'ag', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zacarías', 'zacarias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zac', 'zac', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Za', 'za', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('malaquias', 'Malaquías', 'malaquias', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('malaquias', 'Mal', 'mal', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('malaquias', 'Ml', 'ml', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Mateo', 'mateo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Mt', 'mt', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Mat', 'mat', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Marcos', 'marcos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mr', 'mr', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mc', 'mc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mar', 'mar', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Lucas', 'lucas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Lc', 'lc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Luc', 'luc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Lu', 'lu', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'Juan', 'juan', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'Jn', 'jn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'Jua', 'jua', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Hechos', 'hechos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Hch', 'hch', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Hech', 'hech', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Hec', 'hec', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('romanos', 'Romanos', 'romanos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('romanos', 'Ro', 'ro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('romanos', 'Rm', 'rm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-corintios', '1 Corintios', '1corintios', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-corintios', '1 Co', '1co', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-corintios', '2 Corintios', '2corintios', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-corintios', '2 Co', '2co', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('galatas', 'Gálatas', 'galatas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('galatas', 'Gá', 'ga', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('galatas', 'Gl', 'gl', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('efesios', 'Efesios', 'efesios', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('efesios', 'Ef', 'ef', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('efesios', 'Efe', 'efe', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Filipenses', 'filipenses', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Fil', 'fil', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Flp', 'flp', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('colosenses', 'Colosenses', 'colosenses', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('colosenses', 'Col', 'col', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('colosenses', 'Cl', 'cl', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Tesalonicenses', '1tesalonicenses', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Ts', '1ts', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Tes', '1tes', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Tesalonicenses', '2tesalonicenses', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Ts', '2ts', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Tes', '2tes', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-timoteo', '1 Timoteo', '1timoteo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-timoteo', '1 Ti', '1ti', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-timoteo', '1 Tm', '1tm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-timoteo', '2 Timoteo', '2timoteo', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-timoteo', '2 Ti', '2ti', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-timoteo', '2 Tm', '2tm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('tito', 'Tito', 'tito', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('tito', 'Tit', 'tit', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('tito', 'Tt', 'tt', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Filemón', 'filemon', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Flm', 'flm', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Film', 'film', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'Hebreos', 'hebreos', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'He', 'he', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'Hb', 'hb', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Santiago', 'santiago', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Stg', 'stg', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Sant', 'sant', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Pedro', '1pedro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 P', '1p', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Pe', '1pe', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Ped', '1ped', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Pedro', '2pedro', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 P', '2p', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Pe', '2pe', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Ped', '2ped', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 Juan', '1juan', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 Jn', '1jn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 Jua', '1jua', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 Juan', '2juan', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 Jn', '2jn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 Jua', '2jua', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 Juan', '3juan', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 Jn', '3jn', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 Jua', '3jua', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('judas', 'Judas', 'judas', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('judas', 'Jud', 'jud', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('judas', 'Jds', 'jds', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('judas', 'Jd', 'jd', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Apocalipsis', 'apocalipsis', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Ap', 'ap', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Apoc', 'apoc', 'es', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('genesis', 'Gen', 'gen', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('genesis', 'Ge', 'ge', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('exodo', 'Exodus', 'exodus', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('exodo', 'Exod', 'exod', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('exodo', 'Exo', 'exo', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('levitico', 'Leviticus', 'leviticus', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('levitico', 'Lev', 'lev', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('numeros', 'Numbers', 'numbers', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('numeros', 'Num', 'num', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('numeros', 'Nu', 'nu', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('deuteronomio', 'Deuteronomy', 'deuteronomy', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('deuteronomio', 'Deut', 'deut', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('josue', 'Joshua', 'joshua', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('josue', 'Josh', 'josh', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Judges', 'judges', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Judg', 'judg', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Jdg', 'jdg', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('rut', 'Ruth', 'ruth', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('rut', 'Ru', 'ru', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-samuel', '1 Sam', '1sam', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-samuel', '1 Sa', '1sa', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-samuel', '2 Sam', '2sam', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-samuel', '2 Sa', '2sa', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Kings', '1kings', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Kgs', '1kgs', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Ki', '1ki', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Kings', '2kings', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Kgs', '2kgs', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Ki', '2ki', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Chronicles', '1chronicles', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Chr', '1chr', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-cronicas', '1 Chron', '1chron', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Chronicles', '2chronicles', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Chr', '2chr', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-cronicas', '2 Chron', '2chron', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('esdras', 'Ezra', 'ezra', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('esdras', 'Ezr', 'ezr', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nehemias', 'Nehemiah', 'nehemiah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ester', 'Esther', 'esther', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ester', 'Esth', 'esth', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('job', 'Jb', 'jb', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Psalms', 'psalms', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Psalm', 'psalm', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Ps', 'ps', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Psa', 'psa', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('salmos', 'Pss', 'pss', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Proverbs', 'proverbs', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Prov', 'prov', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Prv', 'prv', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Ecclesiastes', 'ecclesiastes', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Eccl', 'eccl', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Ecc', 'ecc', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('eclesiastes', 'Qoh', 'qoh', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Song of Songs', 'songofsongs', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Song of Solomon', 'songofsolomon', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Canticles', 'canticles', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Song', 'song', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'SS', 'ss', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('isaias', 'Isaiah', 'isaiah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('isaias', 'Isa', 'isa', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jeremias', 'Jeremiah', 'jeremiah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lamentaciones', 'Lamentations', 'lamentations', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lamentaciones', 'Lam', 'lam', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ezequiel', 'Ezekiel', 'ezekiel', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ezequiel', 'Ezek', 'ezek', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ezequiel', 'Eze', 'eze', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('daniel', 'Dan', 'dan', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('oseas', 'Hosea', 'hosea', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('oseas', 'Hos', 'hos', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Obadiah', 'obadiah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Obad', 'obad', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Ob', 'ob', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jonas', 'Jonah', 'jonah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jonas', 'Jnh', 'jnh', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Micah', 'micah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Mic', 'mic', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('habacuc', 'Habakkuk', 'habakkuk', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Zephaniah', 'zephaniah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Zeph', 'zeph', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Zep', 'zep', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Haggai', 'haggai', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Hg', 'hg', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zechariah', 'zechariah', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zech', 'zech', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zec', 'zec', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('malaquias', 'Malachi', 'malachi', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Matthew', 'matthew', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Matt', 'matt', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mark', 'mark', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mk', 'mk', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('marcos', 'Mrk', 'mrk', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Luke', 'luke', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lucas', 'Lk', 'lk', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'John', 'john', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'Jhn', 'jhn', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'Joh', 'joh', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Acts', 'acts', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Act', 'act', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('romanos', 'Romans', 'romans', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('romanos', 'Rom', 'rom', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-corintios', '1 Corinthians', '1corinthians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-corintios', '1 Cor', '1cor', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-corintios', '2 Corinthians', '2corinthians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-corintios', '2 Cor', '2cor', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('galatas', 'Galatians', 'galatians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('galatas', 'Gal', 'gal', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('efesios', 'Ephesians', 'ephesians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('efesios', 'Eph', 'eph', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Philippians', 'philippians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Phil', 'phil', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Php', 'php', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('colosenses', 'Colossians', 'colossians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Thessalonians', '1thessalonians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Thess', '1thess', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Th', '1th', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Thessalonians', '2thessalonians', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Thess', '2thess', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Th', '2th', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-timoteo', '1 Timothy', '1timothy', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-timoteo', '1 Tim', '1tim', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-timoteo', '2 Timothy', '2timothy', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-timoteo', '2 Tim', '2tim', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('tito', 'Titus', 'titus', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Philemon', 'philemon', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Phlm', 'phlm', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Phm', 'phm', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'Hebrews', 'hebrews', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'Heb', 'heb', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'James', 'james', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Jas', 'jas', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Jm', 'jm', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Peter', '1peter', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Pet', '1pet', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-pedro', '1 Pt', '1pt', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Peter', '2peter', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Pet', '2pet', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-pedro', '2 Pt', '2pt', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 John', '1john', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 Jhn', '1jhn', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 John', '2john', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 Jhn', '2jhn', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 John', '3john', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 Jhn', '3jhn', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('judas', 'Jude', 'jude', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Revelation', 'revelation', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Revelations', 'revelations', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Rev', 'rev', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Rv', 'rv', 'en', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('josue', 'Js', 'js', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Juízes', 'juizes', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('jueces', 'Jz', 'jz', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('rut', 'Rute', 'rute', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Reis', '1reis', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-reyes', '1 Rs', '1rs', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Reis', '2reis', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-reyes', '2 Rs', '2rs', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('esdras', 'Ed', 'ed', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nehemias', 'Neemias', 'neemias', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('ester', 'Et', 'et', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('proverbios', 'Pv', 'pv', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cânticos', 'canticos', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('cantares', 'Cântico dos Cânticos', 'canticodoscanticos', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('lamentaciones', 'Lamentações', 'lamentacoes', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('oseas', 'Oséias', 'oseias', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('abdias', 'Obadias', 'obadias', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Miquéias', 'miqueias', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('miqueas', 'Mq', 'mq', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('nahum', 'Naum', 'naum', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('habacuc', 'Habacuque', 'habacuque', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('sofonias', 'Sf', 'sf', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hageo', 'Ageu', 'ageu', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('zacarias', 'Zc', 'zc', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('mateo', 'Mateus', 'mateus', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('juan', 'João', 'joao', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'Atos', 'atos', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hechos', 'At', 'at', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filipenses', 'Fp', 'fp', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('colosenses', 'Colossenses', 'colossenses', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-tesalonicenses', '1 Tessalonicenses', '1tessalonicenses', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-tesalonicenses', '2 Tessalonicenses', '2tessalonicenses', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Filemom', 'filemom', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('filemon', 'Fm', 'fm', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('hebreos', 'Hebreus', 'hebreus', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Tiago', 'tiago', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('santiago', 'Tg', 'tg', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 João', '1joao', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('1-juan', '1 Jo', '1jo', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 João', '2joao', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('2-juan', '2 Jo', '2jo', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 João', '3joao', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('3-juan', '3 Jo', '3jo', 'pt', 0);
+INSERT INTO book_aliases (book, alias, alias_key, language, custom) VALUES ('apocalipsis', 'Apocalipse', 'apocalipse', 'pt', 0);
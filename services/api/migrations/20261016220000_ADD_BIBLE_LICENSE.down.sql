@@ -0,0 +1,2 @@
+ALTER TABLE bibles DROP COLUMN attribution;
+ALTER TABLE bibles DROP COLUMN license;
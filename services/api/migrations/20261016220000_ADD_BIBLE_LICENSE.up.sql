@@ -0,0 +1,4 @@
+ALTER TABLE bibles ADD COLUMN license TEXT NOT NULL DEFAULT '';
+ALTER TABLE bibles ADD COLUMN attribution TEXT NOT NULL DEFAULT '';
+
+UPDATE bibles SET license = 'Reina-Valera 1960 © Sociedades Bíblicas en América Latina, 1960. Renovado © Sociedades Bíblicas Unidas, 1988. Utilizado con permiso.', attribution = 'RVR1960 © Sociedades Bíblicas Unidas' WHERE id = 1 AND abbreviation = 'RVR1960';
@@ -0,0 +1,3 @@
+ALTER TABLE lyrics_songs DROP COLUMN ccli;
+ALTER TABLE lyrics_songs DROP COLUMN copyright;
+ALTER TABLE lyrics_songs DROP COLUMN authors_json;
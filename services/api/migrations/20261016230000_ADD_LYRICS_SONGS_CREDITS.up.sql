@@ -0,0 +1,3 @@
+ALTER TABLE lyrics_songs ADD COLUMN authors_json TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE lyrics_songs ADD COLUMN copyright TEXT NOT NULL DEFAULT '';
+ALTER TABLE lyrics_songs ADD COLUMN ccli TEXT NOT NULL DEFAULT '';
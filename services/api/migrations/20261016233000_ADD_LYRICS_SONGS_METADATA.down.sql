@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS lyrics_song_tags;
+ALTER TABLE lyrics_songs DROP COLUMN search_text;
+ALTER TABLE lyrics_songs DROP COLUMN tempo;
+ALTER TABLE lyrics_songs DROP COLUMN song_key;
@@ -0,0 +1,18 @@
+ALTER TABLE lyrics_songs ADD COLUMN song_key TEXT NOT NULL DEFAULT '';
+ALTER TABLE lyrics_songs ADD COLUMN tempo INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE lyrics_songs ADD COLUMN search_text TEXT NOT NULL DEFAULT '';
+
+UPDATE lyrics_songs
+SET search_text = replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(lower(title || ' ' || authors_json || ' ' || ccli), 'á', 'a'), 'é', 'e'), 'í', 'i'), 'ó', 'o'), 'ú', 'u'), 'ü', 'u'), 'ñ', 'n'), 'Á', 'a'), 'É', 'e'), 'Í', 'i'), 'Ó', 'o'), 'Ú', 'u'), 'Ü', 'u'), 'Ñ', 'n');
+
+CREATE TABLE lyrics_song_tags
+(
+    song_id    TEXT    NOT NULL,
+    position   INTEGER NOT NULL,
+    tag        TEXT    NOT NULL,
+    normalized TEXT    NOT NULL,
+    PRIMARY KEY (song_id, position),
+    FOREIGN KEY (song_id) REFERENCES lyrics_songs (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_lyrics_song_tags_normalized ON lyrics_song_tags(normalized);
@@ -0,0 +1,2 @@
+ALTER TABLE lyrics_songs DROP COLUMN default_arrangement;
+ALTER TABLE lyrics_songs DROP COLUMN arrangements_json;
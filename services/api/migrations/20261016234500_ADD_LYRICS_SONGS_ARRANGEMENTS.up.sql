@@ -0,0 +1,2 @@
+ALTER TABLE lyrics_songs ADD COLUMN arrangements_json TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE lyrics_songs ADD COLUMN default_arrangement TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS verses_vocab_instance;
+DROP INDEX IF EXISTS idx_search_vocabulary_length;
+DROP TABLE IF EXISTS search_vocabulary;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS search_vocabulary
+(
+    bible_id INTEGER NOT NULL,
+    term     TEXT    NOT NULL,
+    length   INTEGER NOT NULL,
+    verses   INTEGER NOT NULL,
+    PRIMARY KEY (bible_id, term),
+    FOREIGN KEY (bible_id) REFERENCES bibles (id)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_search_vocabulary_length ON search_vocabulary(bible_id, length);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS verses_vocab_instance USING fts5vocab
+(
+    verses_fts,
+    instance
+);
+
+INSERT INTO search_vocabulary (bible_id, term, length, verses)
+SELECT b.bible_id, i.term, length(i.term), COUNT(DISTINCT i.doc)
+FROM verses_vocab_instance i
+         INNER JOIN verses v ON v.id = i.doc
+         INNER JOIN chapters c ON c.id = v.chapter
+         INNER JOIN books b ON b.id = c.book_id
+GROUP BY b.bible_id, i.term;
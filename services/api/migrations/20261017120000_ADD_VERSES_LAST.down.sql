@@ -0,0 +1 @@
+ALTER TABLE verses DROP COLUMN "last";
@@ -0,0 +1 @@
+ALTER TABLE verses ADD COLUMN "last" INTEGER NOT NULL DEFAULT 0;
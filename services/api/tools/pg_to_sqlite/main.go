@@ -1,12 +1,14 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"database/sql"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
+	"services/api/internal/dbmigrate"
+	"services/api/internal/infrastructure"
 	"strings"
 
 	_ "github.com/lib/pq"
@@ -16,7 +18,6 @@ import (
 type config struct {
 	PgURL         string
 	SQLitePath    string
-	SchemaPath    string
 	MigrationsDir string
 	Source        string
 }
@@ -40,12 +41,10 @@ func main() {
 	}
 	defer sqliteDB.Close()
 
-	if err := applySchema(sqliteDB, cfg.SchemaPath); err != nil {
-		fatal("cannot apply schema: %v", err)
-	}
-
-	if err := seedBibles(sqliteDB); err != nil {
-		fatal("cannot seed bibles: %v", err)
+	// The schema and the RVR1960 seed come from the same migrations the API
+	// runs at startup, so the bundled database never lags behind them.
+	if err := dbmigrate.Run(context.Background(), sqliteDB, os.DirFS(cfg.MigrationsDir)); err != nil {
+		fatal("cannot apply migrations: %v", err)
 	}
 
 	source := strings.ToLower(cfg.Source)
@@ -61,13 +60,13 @@ func main() {
 		if err := loadFromPostgres(sqliteDB, cfg.PgURL); err != nil {
 			fatal("migration from postgres failed: %v", err)
 		}
+		if err := infrastructure.RebuildSearchVocabulary(context.Background(), sqliteDB); err != nil {
+			fatal("cannot index the search vocabulary: %v", err)
+		}
 		if err := validateCountsFromPostgres(sqliteDB, cfg.PgURL); err != nil {
 			fatal("validation failed: %v", err)
 		}
 	case "migrations":
-		if err := loadFromMigrations(sqliteDB, cfg.MigrationsDir); err != nil {
-			fatal("migration from migrations failed: %v", err)
-		}
 	default:
 		fatal("unknown source: %s", source)
 	}
@@ -83,97 +82,13 @@ func parseFlags() config {
 	var cfg config
 	flag.StringVar(&cfg.PgURL, "pg", os.Getenv("DATABASE_URL"), "Postgres connection string")
 	flag.StringVar(&cfg.SQLitePath, "sqlite", "dist/db/bible.sqlite", "SQLite output path")
-	flag.StringVar(&cfg.SchemaPath, "schema", "tools/schema.sql", "SQLite schema path")
-	flag.StringVar(&cfg.MigrationsDir, "migrations", "migrations", "Migrations directory with INSERT statements")
+	flag.StringVar(&cfg.MigrationsDir, "migrations", "migrations", "Migrations directory")
 	flag.StringVar(&cfg.Source, "source", "postgres", "Source: postgres or migrations")
 	flag.Parse()
 
 	return cfg
 }
 
-func applySchema(db *sql.DB, schemaPath string) error {
-	content, err := os.ReadFile(schemaPath)
-	if err != nil {
-		return err
-	}
-
-	statements := strings.Split(string(content), ";")
-	for _, stmt := range statements {
-		trimmed := strings.TrimSpace(stmt)
-		if trimmed == "" {
-			continue
-		}
-		if _, err := db.Exec(trimmed); err != nil {
-			return fmt.Errorf("schema statement failed: %w", err)
-		}
-	}
-
-	return nil
-}
-
-func seedBibles(db *sql.DB) error {
-	_, err := db.Exec("INSERT OR IGNORE INTO bibles (id, version_name) VALUES (1, 'Reina Valera 1960')")
-	return err
-}
-
-func loadFromMigrations(db *sql.DB, migrationsDir string) error {
-	files := []string{
-		"20240412184408_INSERT_INTO_TABLE_BOOK.up.sql",
-		"20240412184419_INSERT_INTO_TABLE_CHAPTERS.up.sql",
-		"20240412184427_INSERT_INTO_TABLE_VERSES.up.sql",
-		"20240412184450_INSERT_INTO_TABLE_CHAPTERS_VERSES.up.sql",
-	}
-
-	tx, err := db.Begin()
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback()
-
-	for _, file := range files {
-		path := filepath.Join(migrationsDir, file)
-		if err := execInsertFile(tx, path); err != nil {
-			return fmt.Errorf("%s: %w", file, err)
-		}
-	}
-
-	if _, err := tx.Exec("UPDATE books SET bible_id = 1 WHERE bible_id IS NULL"); err != nil {
-		return err
-	}
-
-	return tx.Commit()
-}
-
-func execInsertFile(tx *sql.Tx, path string) error {
-	file, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	buf := make([]byte, 0, 1024*1024)
-	scanner.Buffer(buf, 8*1024*1024)
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "--") {
-			continue
-		}
-		if !strings.HasPrefix(strings.ToUpper(line), "INSERT INTO") {
-			continue
-		}
-		line = strings.Replace(line, "INSERT INTO public.", "INSERT INTO ", 1)
-		line = strings.Replace(line, "(id, book, index, research, title)", "(id, book, \"index\", research, title)", 1)
-		line = strings.Replace(line, "(id, chapter, research, index, content)", "(id, chapter, research, \"index\", content)", 1)
-		if _, err := tx.Exec(line); err != nil {
-			return fmt.Errorf("insert failed: %w", err)
-		}
-	}
-
-	return scanner.Err()
-}
-
 func loadFromPostgres(sqliteDB *sql.DB, pgURL string) error {
 	pgDB, err := sql.Open("postgres", pgURL)
 	if err != nil {
@@ -187,6 +102,9 @@ func loadFromPostgres(sqliteDB *sql.DB, pgURL string) error {
 	}
 	defer tx.Rollback()
 
+	if err := clearScripture(tx); err != nil {
+		return err
+	}
 	if err := copyBibles(pgDB, tx); err != nil {
 		return err
 	}
@@ -206,6 +124,18 @@ func loadFromPostgres(sqliteDB *sql.DB, pgURL string) error {
 	return tx.Commit()
 }
 
+// clearScripture drops the text the seed migrations loaded, so the dump
+// replaces it instead of colliding with it. Bible rows are kept and updated
+// in place, with the metadata the migrations gave them.
+func clearScripture(tx *sql.Tx) error {
+	for _, table := range []string{"chapters_verses", "verses", "chapters", "books"} {
+		if _, err := tx.Exec("DELETE FROM " + table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func copyBibles(pgDB *sql.DB, tx *sql.Tx) error {
 	rows, err := pgDB.Query("SELECT id, version_name FROM bibles ORDER BY id")
 	if err != nil {
@@ -213,7 +143,7 @@ func copyBibles(pgDB *sql.DB, tx *sql.Tx) error {
 	}
 	defer rows.Close()
 
-	stmt, err := tx.Prepare("INSERT INTO bibles (id, version_name) VALUES (?, ?)")
+	stmt, err := tx.Prepare("INSERT INTO bibles (id, version_name) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET version_name = excluded.version_name")
 	if err != nil {
 		return err
 	}
@@ -265,13 +195,17 @@ func copyBooks(pgDB *sql.DB, tx *sql.Tx) error {
 }
 
 func copyChapters(pgDB *sql.DB, tx *sql.Tx) error {
-	rows, err := pgDB.Query("SELECT id, book, index, research, title FROM chapters ORDER BY id")
+	rows, err := pgDB.Query(`SELECT c.id, c.book, COALESCE(b.bible_id, 1), c.index, c.research, c.title
+		FROM chapters c LEFT JOIN books b ON b.name = c.book ORDER BY c.id`)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 
-	stmt, err := tx.Prepare("INSERT INTO chapters (id, book, \"index\", research, title) VALUES (?, ?, ?, ?, ?)")
+	// Postgres keeps the legacy layout, where a chapter names its book; here
+	// it points at the book of that bible by id.
+	stmt, err := tx.Prepare(`INSERT INTO chapters (id, book_id, "index", research, title)
+		SELECT ?, b.id, ?, ?, ? FROM books b WHERE b.bible_id = ? AND b.name = ?`)
 	if err != nil {
 		return err
 	}
@@ -280,14 +214,21 @@ func copyChapters(pgDB *sql.DB, tx *sql.Tx) error {
 	for rows.Next() {
 		var id int
 		var book string
+		var bibleID int64
 		var index int
 		var research sql.NullString
 		var title sql.NullString
-		if err := rows.Scan(&id, &book, &index, &research, &title); err != nil {
+		if err := rows.Scan(&id, &book, &bibleID, &index, &research, &title); err != nil {
+			return err
+		}
+		result, err := stmt.Exec(id, index, nullableString(research), nullableString(title), bibleID, book)
+		if err != nil {
 			return err
 		}
-		if _, err := stmt.Exec(id, book, index, nullableString(research), nullableString(title)); err != nil {
+		if inserted, err := result.RowsAffected(); err != nil {
 			return err
+		} else if inserted == 0 {
+			return fmt.Errorf("chapter %d: unknown book %q", id, book)
 		}
 	}
 
@@ -325,13 +266,14 @@ func copyVerses(pgDB *sql.DB, tx *sql.Tx) error {
 }
 
 func copyChaptersVerses(pgDB *sql.DB, tx *sql.Tx) error {
-	rows, err := pgDB.Query("SELECT id, book, chapter, number_verses FROM chapters_verses ORDER BY id")
+	rows, err := pgDB.Query(`SELECT cv.id, cv.book, COALESCE(b.bible_id, 1), cv.chapter, cv.number_verses
+		FROM chapters_verses cv LEFT JOIN books b ON b.name = cv.book ORDER BY cv.id`)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 
-	stmt, err := tx.Prepare("INSERT INTO chapters_verses (id, book, chapter, number_verses) VALUES (?, ?, ?, ?)")
+	stmt, err := tx.Prepare("INSERT INTO chapters_verses (id, book, chapter, number_verses, bible_id) VALUES (?, ?, ?, ?, ?)")
 	if err != nil {
 		return err
 	}
@@ -340,12 +282,13 @@ func copyChaptersVerses(pgDB *sql.DB, tx *sql.Tx) error {
 	for rows.Next() {
 		var id int
 		var book string
+		var bibleID int64
 		var chapter sql.NullInt64
 		var number sql.NullInt64
-		if err := rows.Scan(&id, &book, &chapter, &number); err != nil {
+		if err := rows.Scan(&id, &book, &bibleID, &chapter, &number); err != nil {
 			return err
 		}
-		if _, err := stmt.Exec(id, book, nullableInt(chapter), nullableInt(number)); err != nil {
+		if _, err := stmt.Exec(id, book, nullableInt(chapter), nullableInt(number), bibleID); err != nil {
 			return err
 		}
 	}